@@ -17,13 +17,36 @@ type Part interface {
 	End() Position
 }
 
+// Positions locate a node in the source pattern. Begin is the location
+// of the node's first rune and End is the location just after its last.
 type Positions struct {
 	Begin Position
 	End   Position
 }
 
+// Position is a 1-based line and column. Columns restart after each
+// newline and are counted both in bytes and in runes.
 type Position struct {
 	Line       int
 	ByteColumn int
 	RuneColumn int
 }
+
+// ArgNumber returns the value of a numbered argument ID such as "0" or
+// "12". It reports false for named arguments and malformed numbers.
+func ArgNumber(id string) (int, bool) {
+	if id == "" || len(id) > 1 && id[0] == '0' {
+		return 0, false
+	}
+	n := 0
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		if ch < '0' || ch > '9' || n > (maxArgNumber-int(ch-'0'))/10 {
+			return 0, false
+		}
+		n = n*10 + int(ch-'0')
+	}
+	return n, true
+}
+
+const maxArgNumber = 1<<31 - 1
@@ -0,0 +1,29 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgNumber(t *testing.T) {
+	require := require.New(t)
+
+	for id, expected := range map[string]int{
+		"0":          0,
+		"7":          7,
+		"42":         42,
+		"2147483647": 2147483647,
+	} {
+		n, ok := ArgNumber(id)
+		require.True(ok, id)
+		require.Equal(expected, n, id)
+	}
+
+	for _, id := range []string{
+		"", "00", "01", "-1", "1st", "name", "2147483648", "99999999999999999999",
+	} {
+		_, ok := ArgNumber(id)
+		require.False(ok, id)
+	}
+}
@@ -0,0 +1,9 @@
+package ast
+
+// BadArg is a placeholder for an argument that could not be parsed.
+// It is only produced when parsing with error recovery.
+type BadArg struct {
+	Positions *Positions
+	Source    string
+	Err       error
+}
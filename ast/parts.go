@@ -7,6 +7,26 @@ type Part struct {
 }
 */
 
+var _ Part = &BadArg{}
+
+func (x *BadArg) HasPositions() bool {
+	return x.Positions != nil
+}
+
+func (x *BadArg) Begin() Position {
+	if x.Positions != nil {
+		return x.Positions.Begin
+	}
+	return Position{}
+}
+
+func (x *BadArg) End() Position {
+	if x.Positions != nil {
+		return x.Positions.End
+	}
+	return Position{}
+}
+
 var _ Part = &Text{}
 
 func (x *Text) HasPositions() bool {
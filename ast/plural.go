@@ -1,13 +1,49 @@
 package ast
 
+import (
+	"sort"
+	"strings"
+)
+
 type NumberSign struct {
 	Positions *Positions
 }
 
 type PluralArg struct {
-	Positions *Positions
-	ArgID     string
-	Ordinal   bool
-	Offset    int
-	Messages  map[string]*Message
+	Positions    *Positions
+	ArgID        string
+	Ordinal      bool
+	Offset       int
+	Messages     map[string]*Message
+	KeyPositions map[string]*Positions
+}
+
+var pluralKeyOrder = map[string]int{
+	"zero":  1,
+	"one":   2,
+	"two":   3,
+	"few":   4,
+	"many":  5,
+	"other": 6,
+}
+
+// Keys returns the branch keys in canonical order: exact matches such as
+// "=0" in numeric order, then plural categories from "zero" to "other".
+func (x *PluralArg) Keys() []string {
+	keys := make([]string, 0, len(x.Messages))
+	for key := range x.Messages {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, aExact := ArgNumber(strings.TrimPrefix(keys[i], "="))
+		z, zExact := ArgNumber(strings.TrimPrefix(keys[j], "="))
+		switch {
+		case aExact && zExact:
+			return a < z
+		case aExact != zExact:
+			return aExact
+		}
+		return pluralKeyOrder[keys[i]] < pluralKeyOrder[keys[j]]
+	})
+	return keys
 }
@@ -0,0 +1,119 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Print renders m as a canonical MessageFormat pattern. Parsing the
+// result produces a tree structurally identical to m.
+func Print(m *Message) string {
+	var b strings.Builder
+	printMessage(&b, m, false)
+	return b.String()
+}
+
+func (m *Message) String() string {
+	return Print(m)
+}
+
+func printMessage(b *strings.Builder, m *Message, inPlural bool) {
+	for _, part := range m.Parts {
+		switch x := part.(type) {
+		case *BadArg:
+			b.WriteString(x.Source)
+		case *NumberSign:
+			b.WriteByte('#')
+		case *PlainArg:
+			b.WriteByte('{')
+			b.WriteString(x.ArgID)
+			b.WriteByte('}')
+		case *PluralArg:
+			printPluralArg(b, x)
+		case *SelectArg:
+			printSelectArg(b, x)
+		case *SimpleArg:
+			printSimpleArg(b, x)
+		case *Text:
+			printText(b, x.Value, inPlural)
+		}
+	}
+}
+
+func printPluralArg(b *strings.Builder, x *PluralArg) {
+	b.WriteByte('{')
+	b.WriteString(x.ArgID)
+	if x.Ordinal {
+		b.WriteString(", selectordinal,")
+	} else {
+		b.WriteString(", plural,")
+	}
+	if x.Offset != 0 {
+		b.WriteString(" offset:")
+		b.WriteString(strconv.Itoa(x.Offset))
+	}
+	printBranches(b, x.Keys(), x.Messages, true)
+	b.WriteByte('}')
+}
+
+func printSelectArg(b *strings.Builder, x *SelectArg) {
+	b.WriteByte('{')
+	b.WriteString(x.ArgID)
+	b.WriteString(", select,")
+	printBranches(b, x.Keys(), x.Messages, false)
+	b.WriteByte('}')
+}
+
+func printBranches(b *strings.Builder, keys []string, messages map[string]*Message, inPlural bool) {
+	for _, key := range keys {
+		b.WriteByte(' ')
+		b.WriteString(key)
+		b.WriteString(" {")
+		printMessage(b, messages[key], inPlural)
+		b.WriteByte('}')
+	}
+}
+
+func printSimpleArg(b *strings.Builder, x *SimpleArg) {
+	b.WriteByte('{')
+	b.WriteString(x.ArgID)
+	b.WriteString(", ")
+	b.WriteString(x.ArgType.ToKeyword())
+	if x.ArgStyle == SkeletonStyle {
+		b.WriteString(", ::")
+		b.WriteString(x.Skeleton)
+	} else if x.ArgStyle == TextStyle {
+		b.WriteString(", ")
+		b.WriteString(x.StyleText)
+	} else if style := x.ArgStyle.ToKeyword(); style != "" {
+		b.WriteString(", ")
+		b.WriteString(style)
+	}
+	b.WriteByte('}')
+}
+
+// printText doubles apostrophes and quotes runs of characters that would
+// otherwise be parsed as syntax. Apostrophes are doubled inside quoted runs
+// too, so they never end a run early.
+func printText(b *strings.Builder, s string, inPlural bool) {
+	quoted := false
+	for _, ch := range s {
+		special := ch == '{' || ch == '}' || (inPlural && ch == '#')
+		switch {
+		case special && !quoted:
+			b.WriteByte('\'')
+			quoted = true
+		case !special && ch != '\'' && quoted:
+			b.WriteByte('\'')
+			quoted = false
+		}
+		if ch == '\'' {
+			b.WriteString("''")
+		} else {
+			b.WriteRune(ch)
+		}
+	}
+	if quoted {
+		b.WriteByte('\'')
+	}
+}
@@ -0,0 +1,142 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/parser"
+)
+
+func clearPositions(m *ast.Message) {
+	ast.Inspect(m, func(node ast.Node) bool {
+		switch x := node.(type) {
+		case *ast.BadArg:
+			x.Positions = nil
+		case *ast.NumberSign:
+			x.Positions = nil
+		case *ast.PlainArg:
+			x.Positions = nil
+		case *ast.PluralArg:
+			x.Positions = nil
+			x.KeyPositions = nil
+		case *ast.SelectArg:
+			x.Positions = nil
+			x.KeyPositions = nil
+		case *ast.SimpleArg:
+			x.Positions = nil
+		case *ast.Text:
+			x.Positions = nil
+		}
+		return true
+	})
+}
+
+func text(s string) *ast.Text {
+	return &ast.Text{Value: s}
+}
+
+func msg(parts ...ast.Part) *ast.Message {
+	return &ast.Message{Parts: parts}
+}
+
+func TestPrint(t *testing.T) {
+	for _, tc := range []struct {
+		message  *ast.Message
+		expected string
+	}{
+		{msg(text("Hello, World!")), "Hello, World!"},
+		{msg(text("It's {literally} '#1'")), "It''s '{'literally'}' ''#1''"},
+		{msg(text("{'}")), "'{''}'"},
+		{msg(text("a}'b")), "a'}'''b"},
+		{msg(
+			text("Hello, "),
+			&ast.PlainArg{ArgID: "name"},
+			text("!"),
+		), "Hello, {name}!"},
+		{msg(
+			&ast.SimpleArg{ArgID: "0", ArgType: ast.NumberType},
+			text(" "),
+			&ast.SimpleArg{ArgID: "when", ArgType: ast.DateType, ArgStyle: ast.ShortStyle},
+		), "{0, number} {when, date, short}"},
+		{msg(
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType, ArgStyle: ast.TextStyle, StyleText: "%spellout-ordinal"},
+		), "{n, spellout, %spellout-ordinal}"},
+		{msg(
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "currency/EUR .00"},
+		), "{n, number, ::currency/EUR .00}"},
+		{msg(&ast.PluralArg{
+			ArgID:  "guests",
+			Offset: 1,
+			Messages: map[string]*ast.Message{
+				"other": msg(text("# guests #1 {x}")),
+				"one":   msg(&ast.NumberSign{}, text(" guest")),
+				"=10":   msg(text("ten")),
+				"=0":    msg(text("none")),
+				"few":   msg(text("few")),
+			},
+		}), "{guests, plural, offset:1 =0 {none} =10 {ten} one {# guest} few {few} other {'#' guests '#'1 '{'x'}'}}"},
+		{msg(&ast.PluralArg{
+			ArgID:   "n",
+			Ordinal: true,
+			Messages: map[string]*ast.Message{
+				"one":   msg(&ast.NumberSign{}, text("st")),
+				"other": msg(&ast.NumberSign{}, text("th")),
+			},
+		}), "{n, selectordinal, one {#st} other {#th}}"},
+		{msg(&ast.SelectArg{
+			ArgID: "gender",
+			Messages: map[string]*ast.Message{
+				"other":  msg(text("They #1")),
+				"female": msg(text("She")),
+				"male":   msg(text("He")),
+			},
+		}), "{gender, select, female {She} male {He} other {They #1}}"},
+		{msg(&ast.PluralArg{
+			ArgID: "n",
+			Messages: map[string]*ast.Message{
+				"other": msg(&ast.SelectArg{
+					ArgID: "x",
+					Messages: map[string]*ast.Message{
+						"other": msg(text("#")),
+					},
+				}),
+			},
+		}), "{n, plural, other {{x, select, other {#}}}}"},
+	} {
+		actual := ast.Print(tc.message)
+		require.Equal(t, tc.expected, actual)
+		require.Equal(t, tc.expected, tc.message.String())
+
+		parsed, err := parser.Parse(actual)
+		require.NoError(t, err, actual)
+		clearPositions(parsed)
+		require.Equal(t, tc.message, parsed, actual)
+	}
+}
+
+func TestPrintRoundTrip(t *testing.T) {
+	for _, pattern := range []string{
+		"",
+		"'{'quoted'}' and ''doubled''",
+		"{0} {1, number, integer} {2, time, full}",
+		"{n, spellout} {n, spellout, %spellout-ordinal}",
+		"{n, number, ::compact-short} {d, date, ::yMMMd}",
+		"{n, number, #,##0.00;(#,##0.00)} {d, date, yyyy-MM-dd 'at' HH:mm}",
+		"{count, plural, =0 {none} one {# item} other {'#' # items '{''}'}}",
+		"{rank, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}",
+		"{g, select, female {{n, plural, one {her #} other {her # '#'}}} other {#}}",
+	} {
+		expected, err := parser.Parse(pattern)
+		require.NoError(t, err, pattern)
+		clearPositions(expected)
+
+		printed := ast.Print(expected)
+		actual, err := parser.Parse(printed)
+		require.NoError(t, err, printed)
+		clearPositions(actual)
+		require.Equal(t, expected, actual, printed)
+		require.Equal(t, printed, ast.Print(actual))
+	}
+}
@@ -0,0 +1,145 @@
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ArgKind is the kind of value an argument must be given.
+type ArgKind int
+
+const (
+	StringKind ArgKind = iota
+	NumberKind
+	DateKind
+	DurationKind
+)
+
+func (k ArgKind) String() string {
+	switch k {
+	case StringKind:
+		return "string"
+	case NumberKind:
+		return "number"
+	case DateKind:
+		return "date"
+	case DurationKind:
+		return "duration"
+	default:
+		return fmt.Sprintf("ArgKind(%d)", int(k))
+	}
+}
+
+// ArgInfo describes how a message uses one argument.
+type ArgInfo struct {
+	ID   string
+	Kind ArgKind
+	Keys []string   // explicit select keys, sorted, without "other"
+	Args []Argument // every use of ID, in Walk order
+}
+
+// Conflict is reported when one argument is used with incompatible kinds.
+type Conflict struct {
+	ID    string
+	Kinds []ArgKind // in order of first use
+	Args  []Argument
+}
+
+func (c *Conflict) Error() string {
+	kinds := make([]string, len(c.Kinds))
+	for i, kind := range c.Kinds {
+		kinds[i] = kind.String()
+	}
+	return fmt.Sprintf("argument %q used as %s", c.ID, strings.Join(kinds, " and "))
+}
+
+// Arguments lists the arguments of m in order of first use. The kind of
+// an argument comes from its plural, select and typed uses; plain uses
+// such as "{name}" accept any value, so they only make an argument a
+// string when nothing else constrains it.
+func Arguments(m *Message) ([]*ArgInfo, []*Conflict) {
+	var infos []*ArgInfo
+	index := map[string]*ArgInfo{}
+	kinds := map[string][]ArgKind{}
+	keys := map[string]map[string]bool{}
+
+	Inspect(m, func(node Node) bool {
+		arg, ok := node.(Argument)
+		if !ok {
+			return true
+		}
+
+		id := arg.ArgNameOrNumber()
+		info, ok := index[id]
+		if !ok {
+			info = &ArgInfo{ID: id}
+			index[id] = info
+			infos = append(infos, info)
+		}
+		info.Args = append(info.Args, arg)
+
+		kind, constrained := argKind(arg)
+		if constrained && !hasKind(kinds[id], kind) {
+			kinds[id] = append(kinds[id], kind)
+		}
+		if x, ok := arg.(*SelectArg); ok {
+			if keys[id] == nil {
+				keys[id] = map[string]bool{}
+			}
+			for key := range x.Messages {
+				if key != "other" {
+					keys[id][key] = true
+				}
+			}
+		}
+		return true
+	})
+
+	var conflicts []*Conflict
+	for _, info := range infos {
+		if seen := kinds[info.ID]; len(seen) > 0 {
+			info.Kind = seen[0]
+			if len(seen) > 1 {
+				conflicts = append(conflicts, &Conflict{
+					ID:    info.ID,
+					Kinds: seen,
+					Args:  info.Args,
+				})
+			}
+		}
+		for key := range keys[info.ID] {
+			info.Keys = append(info.Keys, key)
+		}
+		sort.Strings(info.Keys)
+	}
+	return infos, conflicts
+}
+
+func argKind(arg Argument) (ArgKind, bool) {
+	switch x := arg.(type) {
+	case *PluralArg:
+		return NumberKind, true
+	case *SelectArg:
+		return StringKind, true
+	case *SimpleArg:
+		switch x.ArgType {
+		case DateType, TimeType:
+			return DateKind, true
+		case DurationType:
+			return DurationKind, true
+		default:
+			return NumberKind, true
+		}
+	}
+	return StringKind, false
+}
+
+func hasKind(kinds []ArgKind, kind ArgKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/parser"
+)
+
+func TestArguments(t *testing.T) {
+	require := require.New(t)
+
+	m, err := parser.Parse(
+		"{host} invites {guests, plural, =0 {nobody} one {{guest}} other {# people}} " +
+			"to {gender, select, male {his} female {her} other {their}} party " +
+			"on {when, date, long} at {when, time, short} for {length, duration}. " +
+			"{gender, select, nonbinary {!} other {}}{guests}",
+	)
+	require.NoError(err)
+
+	infos, conflicts := ast.Arguments(m)
+	require.Empty(conflicts)
+
+	type summary struct {
+		ID   string
+		Kind ast.ArgKind
+		Keys []string
+		Uses int
+	}
+	var actual []summary
+	for _, info := range infos {
+		actual = append(actual, summary{info.ID, info.Kind, info.Keys, len(info.Args)})
+	}
+	require.Equal([]summary{
+		{"host", ast.StringKind, nil, 1},
+		{"guests", ast.NumberKind, nil, 2},
+		{"guest", ast.StringKind, nil, 1},
+		{"gender", ast.StringKind, []string{"female", "male", "nonbinary"}, 2},
+		{"when", ast.DateKind, nil, 2},
+		{"length", ast.DurationKind, nil, 1},
+	}, actual)
+}
+
+func TestArgumentsConflicts(t *testing.T) {
+	require := require.New(t)
+
+	m, err := parser.Parse(
+		"{0, plural, one {{1, select, x {y} other {z}}} other {{1, number}}} {0, date} {0}",
+	)
+	require.NoError(err)
+
+	infos, conflicts := ast.Arguments(m)
+	require.Len(infos, 2)
+	require.Equal(ast.NumberKind, infos[0].Kind)
+	require.Equal(ast.StringKind, infos[1].Kind)
+	require.Equal([]string{"x"}, infos[1].Keys)
+
+	require.Len(conflicts, 2)
+	require.Equal("0", conflicts[0].ID)
+	require.Equal([]ast.ArgKind{ast.NumberKind, ast.DateKind}, conflicts[0].Kinds)
+	require.Len(conflicts[0].Args, 3)
+	require.EqualError(conflicts[0], `argument "0" used as number and date`)
+	require.EqualError(conflicts[1], `argument "1" used as string and number`)
+}
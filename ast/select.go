@@ -1,7 +1,25 @@
 package ast
 
+import "sort"
+
 type SelectArg struct {
-	Positions *Positions
-	ArgID     string
-	Messages  map[string]*Message
+	Positions    *Positions
+	ArgID        string
+	Messages     map[string]*Message
+	KeyPositions map[string]*Positions
+}
+
+// Keys returns the branch keys sorted alphabetically, with "other" last.
+func (x *SelectArg) Keys() []string {
+	keys := make([]string, 0, len(x.Messages))
+	for key := range x.Messages {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i] == "other" || keys[j] == "other" {
+			return keys[j] == "other"
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
 }
@@ -9,6 +9,8 @@ type SimpleArg struct {
 	ArgID     string
 	ArgType   ArgType
 	ArgStyle  ArgStyle
+	StyleText string // set when ArgStyle is TextStyle
+	Skeleton  string // set when ArgStyle is SkeletonStyle, without "::"
 }
 
 const (
@@ -66,6 +68,8 @@ const (
 	MediumStyle
 	PercentStyle
 	ShortStyle
+	TextStyle
+	SkeletonStyle
 	InvalidStyle
 )
 
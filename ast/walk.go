@@ -0,0 +1,63 @@
+package ast
+
+// Node is a *Message, a *Branch or any Part.
+type Node interface{}
+
+// Branch is one keyed alternative of a PluralArg or SelectArg. Branches
+// are created during traversal and are not stored in the tree.
+type Branch struct {
+	Key       string
+	Positions *Positions // location of Key, if known
+	Message   *Message
+}
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children of
+// node with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses a message tree in depth-first order. Messages are
+// followed by their parts, and plural and select arguments by their
+// branches in the order returned by Keys.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	switch x := node.(type) {
+	case *Message:
+		for _, part := range x.Parts {
+			Walk(v, part)
+		}
+	case *Branch:
+		Walk(v, x.Message)
+	case *PluralArg:
+		for _, key := range x.Keys() {
+			Walk(v, &Branch{Key: key, Positions: x.KeyPositions[key], Message: x.Messages[key]})
+		}
+	case *SelectArg:
+		for _, key := range x.Keys() {
+			Walk(v, &Branch{Key: key, Positions: x.KeyPositions[key], Message: x.Messages[key]})
+		}
+	}
+
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses a message tree in the same order as Walk. It calls
+// f(node) for each node; if f returns true, Inspect invokes f for each of
+// the children of node, followed by a call of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
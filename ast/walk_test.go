@@ -0,0 +1,97 @@
+package ast
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	nodes []string
+}
+
+func (r *recorder) Visit(node Node) Visitor {
+	switch x := node.(type) {
+	case nil:
+		r.nodes = append(r.nodes, "end")
+	case *Message:
+		r.nodes = append(r.nodes, "message")
+	case *Branch:
+		r.nodes = append(r.nodes, "branch "+x.Key)
+	case *PlainArg:
+		r.nodes = append(r.nodes, "arg "+x.ArgID)
+	case *PluralArg:
+		r.nodes = append(r.nodes, "plural "+x.ArgID)
+	case *SelectArg:
+		r.nodes = append(r.nodes, "select "+x.ArgID)
+	case *Text:
+		r.nodes = append(r.nodes, fmt.Sprintf("text %q", x.Value))
+	default:
+		r.nodes = append(r.nodes, fmt.Sprintf("%T", x))
+	}
+	return r
+}
+
+func newTestMessage() *Message {
+	return &Message{Parts: []Part{
+		&PlainArg{ArgID: "host"},
+		&Text{Value: " invites "},
+		&PluralArg{
+			ArgID: "guests",
+			Messages: map[string]*Message{
+				"other": {Parts: []Part{&NumberSign{}, &Text{Value: " guests"}}},
+				"one": {Parts: []Part{&SelectArg{
+					ArgID: "gender",
+					Messages: map[string]*Message{
+						"other":  {Parts: []Part{&Text{Value: "them"}}},
+						"female": {Parts: []Part{&Text{Value: "her"}}},
+					},
+				}}},
+				"=0": {Parts: []Part{&Text{Value: "nobody"}}},
+			},
+		},
+	}}
+}
+
+func TestWalk(t *testing.T) {
+	require := require.New(t)
+
+	r := &recorder{}
+	Walk(r, newTestMessage())
+	require.Equal([]string{
+		"message",
+		"arg host", "end",
+		`text " invites "`, "end",
+		"plural guests",
+		"branch =0", "message", `text "nobody"`, "end", "end", "end",
+		"branch one", "message",
+		"select gender",
+		"branch female", "message", `text "her"`, "end", "end", "end",
+		"branch other", "message", `text "them"`, "end", "end", "end",
+		"end",
+		"end", "end",
+		"branch other", "message", "*ast.NumberSign", "end", `text " guests"`, "end", "end", "end",
+		"end",
+		"end",
+	}, r.nodes)
+}
+
+func TestInspect(t *testing.T) {
+	require := require.New(t)
+
+	var args []string
+	Inspect(newTestMessage(), func(node Node) bool {
+		switch x := node.(type) {
+		case *PlainArg:
+			args = append(args, x.ArgID)
+		case *PluralArg:
+			args = append(args, x.ArgID)
+		case *SelectArg:
+			args = append(args, x.ArgID)
+			return false
+		}
+		return true
+	})
+	require.Equal([]string{"host", "guests", "gender"}, args)
+}
@@ -0,0 +1,195 @@
+package messageformat
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Bundle is a catalog of compiled messages keyed by language and message
+// ID. It is safe for concurrent use; lookups may run in parallel with
+// each other and with additions.
+//
+// Lookups fall back from a language to its CLDR parents, for example from
+// pt-BR to pt, and finally to the bundle's default language.
+//
+// The options given to NewBundle apply to every message. To show dates in
+// the time zone of each user, look the message up and render it with In:
+//
+//	msg, _, _ := b.Lookup(lang, "created")
+//	s, err := msg.In(userLocation).Format(arguments)
+type Bundle struct {
+	mu          sync.RWMutex
+	defaultLang language.Tag
+	options     []Option
+	messages    map[language.Tag]map[string]*Message
+	supported   []language.Tag
+	matcher     language.Matcher
+}
+
+// NotFoundError is returned when a bundle has no message with the
+// requested ID for a language.
+type NotFoundError struct {
+	Lang language.Tag
+	ID   string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("message not found: %q (%s)", e.ID, e.Lang)
+}
+
+// NewBundle returns an empty bundle that falls back to defaultLang when
+// no better language has a message. Messages are compiled with options.
+func NewBundle(defaultLang language.Tag, options ...Option) *Bundle {
+	return &Bundle{
+		defaultLang: defaultLang,
+		options:     options,
+		messages:    map[language.Tag]map[string]*Message{},
+		supported:   []language.Tag{defaultLang},
+		matcher:     language.NewMatcher([]language.Tag{defaultLang}),
+	}
+}
+
+// DefaultLanguage returns the language used when no other matches.
+func (b *Bundle) DefaultLanguage() language.Tag {
+	return b.defaultLang
+}
+
+// Add compiles pattern and stores it as message id for lang, replacing
+// any existing message with the same ID.
+func (b *Bundle) Add(lang language.Tag, id, pattern string) error {
+	msg, err := Compile(lang.String(), pattern, b.options...)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", lang, id, err)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.add(lang, id, msg)
+	return nil
+}
+
+// AddMessages compiles and stores several patterns for lang. Nothing is
+// stored unless every pattern compiles.
+func (b *Bundle) AddMessages(lang language.Tag, patterns map[string]string) error {
+	compiled := make(map[string]*Message, len(patterns))
+	for id, pattern := range patterns {
+		msg, err := Compile(lang.String(), pattern, b.options...)
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", lang, id, err)
+		}
+		compiled[id] = msg
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for id, msg := range compiled {
+		b.add(lang, id, msg)
+	}
+	return nil
+}
+
+func (b *Bundle) add(lang language.Tag, id string, msg *Message) {
+	messages, ok := b.messages[lang]
+	if !ok {
+		messages = map[string]*Message{}
+		b.messages[lang] = messages
+		// The default language is listed first, as language.Matcher
+		// falls back to the first supported tag.
+		b.supported = []language.Tag{b.defaultLang}
+		for _, tag := range b.languages() {
+			if tag != b.defaultLang {
+				b.supported = append(b.supported, tag)
+			}
+		}
+		b.matcher = language.NewMatcher(b.supported)
+	}
+	messages[id] = msg
+}
+
+// Languages returns the languages that have at least one message.
+func (b *Bundle) Languages() []language.Tag {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.languages()
+}
+
+func (b *Bundle) languages() []language.Tag {
+	tags := make([]language.Tag, 0, len(b.messages))
+	for tag := range b.messages {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].String() < tags[j].String()
+	})
+	return tags
+}
+
+// Match returns the supported language that best matches the user's
+// preferred languages, or the default language if none match.
+func (b *Bundle) Match(preferred ...language.Tag) language.Tag {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, idx, confidence := b.matcher.Match(preferred...)
+	if confidence == language.No {
+		return b.defaultLang
+	}
+	return b.supported[idx]
+}
+
+// MatchAcceptLanguage is like Match but takes the value of an HTTP
+// Accept-Language header. Malformed headers match the default language.
+func (b *Bundle) MatchAcceptLanguage(header string) language.Tag {
+	preferred, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(preferred) == 0 {
+		return b.defaultLang
+	}
+	return b.Match(preferred...)
+}
+
+// Message returns the compiled message id for exactly lang, without
+// falling back to other languages.
+func (b *Bundle) Message(lang language.Tag, id string) (*Message, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	msg, ok := b.messages[lang][id]
+	return msg, ok
+}
+
+// Lookup returns message id for lang or, failing that, for the nearest
+// parent of lang or the default language. It also returns the language
+// of the message found.
+func (b *Bundle) Lookup(lang language.Tag, id string) (*Message, language.Tag, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for tag := lang; ; tag = tag.Parent() {
+		if msg, ok := b.messages[tag][id]; ok {
+			return msg, tag, true
+		}
+		if tag == language.Und {
+			break
+		}
+	}
+	if msg, ok := b.messages[b.defaultLang][id]; ok {
+		return msg, b.defaultLang, true
+	}
+	return nil, language.Und, false
+}
+
+// Format renders message id for lang, falling back as described for
+// Lookup. See Message.Format for the accepted arguments.
+func (b *Bundle) Format(lang language.Tag, id string, arguments interface{}) (string, error) {
+	s, _, err := b.Localize(lang, id, arguments)
+	return s, err
+}
+
+// Localize is like Format but also returns the language of the message
+// that was rendered.
+func (b *Bundle) Localize(lang language.Tag, id string, arguments interface{}) (string, language.Tag, error) {
+	msg, served, ok := b.Lookup(lang, id)
+	if !ok {
+		return "", language.Und, &NotFoundError{Lang: lang, ID: id}
+	}
+	s, err := msg.Format(arguments)
+	return s, served, err
+}
@@ -0,0 +1,167 @@
+package messageformat
+
+import (
+	stderrors "errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func newTestBundle(t *testing.T) *Bundle {
+	b := NewBundle(language.English)
+	require.NoError(t, b.AddMessages(language.English, map[string]string{
+		"greeting": "Hello, {name}!",
+		"inbox":    messages["en"],
+	}))
+	require.NoError(t, b.AddMessages(language.Portuguese, map[string]string{
+		"greeting": "Olá, {name}!",
+		"inbox":    messages["pt"],
+	}))
+	return b
+}
+
+func TestBundle(t *testing.T) {
+	require := require.New(t)
+
+	b := newTestBundle(t)
+	require.Equal([]language.Tag{language.English, language.Portuguese}, b.Languages())
+
+	actual, err := b.Format(language.Portuguese, "greeting", map[string]interface{}{"name": "Ana"})
+	require.NoError(err)
+	require.Equal("Olá, Ana!", actual)
+
+	actual, err = b.Format(language.English, "inbox", map[string]interface{}{"n": 2})
+	require.NoError(err)
+	require.Equal("There are 2 items in your inbox.", actual)
+
+	require.NoError(b.Add(language.English, "greeting", "Hi, {name}!"))
+	actual, err = b.Format(language.English, "greeting", map[string]interface{}{"name": "Bob"})
+	require.NoError(err)
+	require.Equal("Hi, Bob!", actual)
+
+	msg, ok := b.Message(language.Portuguese, "inbox")
+	require.True(ok)
+	require.Equal("pt", msg.Language())
+}
+
+func TestBundleFallback(t *testing.T) {
+	require := require.New(t)
+
+	b := newTestBundle(t)
+	require.NoError(b.Add(language.BrazilianPortuguese, "greeting", "Oi, {name}!"))
+	require.NoError(b.Add(language.MustParse("en-AU"), "greeting", "G'day, {name}!"))
+
+	for _, tc := range []struct {
+		lang     string
+		id       string
+		expected string
+		served   language.Tag
+	}{
+		{"pt-BR", "greeting", "Oi, Ana!", language.BrazilianPortuguese},
+		{"pt-BR", "inbox", "Existem 2 itens na sua caixa de entrada.", language.Portuguese},
+		{"pt-PT", "greeting", "Olá, Ana!", language.Portuguese},
+		{"en-NZ", "greeting", "Hello, Ana!", language.English},
+		{"en-AU", "inbox", "There are 2 items in your inbox.", language.English},
+		{"es-MX", "greeting", "Hello, Ana!", language.English},
+	} {
+		actual, served, err := b.Localize(language.MustParse(tc.lang), tc.id, map[string]interface{}{
+			"name": "Ana",
+			"n":    2,
+		})
+		require.NoError(err)
+		require.Equal(tc.expected, actual, tc.lang)
+		require.Equal(tc.served, served, tc.lang)
+	}
+
+	_, ok := b.Message(language.BrazilianPortuguese, "inbox")
+	require.False(ok)
+}
+
+func TestBundleMatch(t *testing.T) {
+	require := require.New(t)
+
+	b := NewBundle(language.English)
+	require.Equal(language.English, b.Match(language.Portuguese))
+
+	b = newTestBundle(t)
+	require.NoError(b.Add(language.BrazilianPortuguese, "greeting", "Oi, {name}!"))
+
+	require.Equal(language.Portuguese, b.Match(language.MustParse("pt-PT")))
+	require.Equal(language.BrazilianPortuguese, b.Match(language.MustParse("pt-BR")))
+	require.Equal(language.English, b.Match(language.MustParse("en-GB")))
+	require.Equal(language.English, b.Match(language.Japanese))
+	require.Equal(language.Portuguese, b.Match(language.Spanish, language.Portuguese))
+
+	require.Equal(language.BrazilianPortuguese, b.MatchAcceptLanguage("fr-CH, pt-BR;q=0.9, en;q=0.8"))
+	require.Equal(language.English, b.MatchAcceptLanguage("de-CH, de;q=0.9"))
+	require.Equal(language.English, b.MatchAcceptLanguage("!!!"))
+}
+
+func TestBundleErrors(t *testing.T) {
+	require := require.New(t)
+
+	b := newTestBundle(t)
+
+	_, err := b.Format(language.Spanish, "farewell", nil)
+	var notFound *NotFoundError
+	require.True(stderrors.As(err, &notFound))
+	require.Equal(language.Spanish, notFound.Lang)
+	require.Equal("farewell", notFound.ID)
+
+	require.Error(b.Add(language.English, "broken", "{n, plural, one{#}}"))
+	err = b.AddMessages(language.English, map[string]string{
+		"fine":   "Spoon!",
+		"broken": "Hello, {name",
+	})
+	require.Error(err)
+	_, ok := b.Message(language.English, "fine")
+	require.False(ok)
+}
+
+func TestBundleOptions(t *testing.T) {
+	require := require.New(t)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(err)
+	at := time.Date(2020, time.July, 15, 18, 30, 0, 0, time.UTC)
+
+	b := NewBundle(language.German, WithLocation(berlin), WithClock(func() time.Time { return at }))
+	require.NoError(b.Add(language.German, "created", "Erstellt um {d, time, short}."))
+
+	actual, err := b.Format(language.German, "created", map[string]interface{}{"d": Now})
+	require.NoError(err)
+	require.Equal("Erstellt um 20:30.", actual)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(err)
+	msg, _, ok := b.Lookup(language.German, "created")
+	require.True(ok)
+	actual, err = msg.In(tokyo).Format(map[string]interface{}{"d": Now})
+	require.NoError(err)
+	require.Equal("Erstellt um 03:30.", actual)
+}
+
+func TestBundleConcurrency(t *testing.T) {
+	b := newTestBundle(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			id := "extra" + strconv.Itoa(i)
+			require.NoError(t, b.Add(language.English, id, "Extra {n}"))
+		}(i)
+		go func() {
+			defer wg.Done()
+			actual, err := b.Format(language.English, "greeting", map[string]interface{}{"name": "Eve"})
+			require.NoError(t, err)
+			require.Equal(t, "Hello, Eve!", actual)
+		}()
+	}
+	wg.Wait()
+}
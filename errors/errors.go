@@ -1,11 +1,229 @@
+// Package errors defines the errors reported while parsing patterns.
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sjansen/messageformat/ast"
+)
+
+// Code identifies a kind of error. Codes are stable across releases and
+// are suitable for matching in tools.
+type Code string
+
+const (
+	UnexpectedTokenCode      Code = "unexpected-token"
+	UnexpectedEOFCode        Code = "unexpected-eof"
+	UnterminatedArgumentCode Code = "unterminated-argument"
+	InvalidArgIDCode         Code = "invalid-arg-id"
+	InvalidArgTypeCode       Code = "invalid-arg-type"
+	InvalidArgStyleCode      Code = "invalid-arg-style"
+	InvalidPluralKeyCode     Code = "invalid-plural-key"
+	InvalidPluralOffsetCode  Code = "invalid-plural-offset"
+)
+
+// Error is implemented by every error the parser reports.
+type Error interface {
+	error
+	Code() Code
+	Position() ast.Position
+	ExpectedTokens() []string
+}
+
+var (
+	_ Error = &UnexpectedToken{}
+	_ Error = &UnexpectedEOF{}
+	_ Error = &UnterminatedArgument{}
+	_ Error = &InvalidArgID{}
+	_ Error = &InvalidArgType{}
+	_ Error = &InvalidArgStyle{}
+	_ Error = &InvalidPluralKey{}
+	_ Error = &InvalidPluralOffset{}
+)
 
 type UnexpectedToken struct {
-	Token string
+	Pos      ast.Position
+	Token    string
+	Expected []string
 }
 
 func (e *UnexpectedToken) Error() string {
-	return fmt.Sprintf("Unexpected token: %q", e.Token)
+	return describe(fmt.Sprintf("Unexpected token: %q", e.Token), e.Pos, e.Expected)
+}
+
+func (e *UnexpectedToken) Code() Code               { return UnexpectedTokenCode }
+func (e *UnexpectedToken) Position() ast.Position   { return e.Pos }
+func (e *UnexpectedToken) ExpectedTokens() []string { return e.Expected }
+
+type UnexpectedEOF struct {
+	Pos      ast.Position
+	Expected []string
+}
+
+func (e *UnexpectedEOF) Error() string {
+	return describe("Unexpected end of pattern", e.Pos, e.Expected)
+}
+
+func (e *UnexpectedEOF) Code() Code               { return UnexpectedEOFCode }
+func (e *UnexpectedEOF) Position() ast.Position   { return e.Pos }
+func (e *UnexpectedEOF) ExpectedTokens() []string { return e.Expected }
+
+// UnterminatedArgument is reported when the pattern ends before an
+// argument's closing brace. Begin is the location of the opening brace.
+type UnterminatedArgument struct {
+	Pos      ast.Position
+	Begin    ast.Position
+	Expected []string
+}
+
+func (e *UnterminatedArgument) Error() string {
+	return describe(
+		fmt.Sprintf("Unterminated argument (opened at line %d, column %d)", e.Begin.Line, e.Begin.RuneColumn),
+		e.Pos, e.Expected,
+	)
+}
+
+func (e *UnterminatedArgument) Code() Code               { return UnterminatedArgumentCode }
+func (e *UnterminatedArgument) Position() ast.Position   { return e.Pos }
+func (e *UnterminatedArgument) ExpectedTokens() []string { return e.Expected }
+
+// InvalidArgID is reported for argument IDs that start with a digit but
+// are not a valid argument number, such as "01" or "1st".
+type InvalidArgID struct {
+	Pos      ast.Position
+	ID       string
+	Expected []string
+}
+
+func (e *InvalidArgID) Error() string {
+	return describe(fmt.Sprintf("Invalid argument ID: %q", e.ID), e.Pos, e.Expected)
+}
+
+func (e *InvalidArgID) Code() Code               { return InvalidArgIDCode }
+func (e *InvalidArgID) Position() ast.Position   { return e.Pos }
+func (e *InvalidArgID) ExpectedTokens() []string { return e.Expected }
+
+type InvalidArgType struct {
+	Pos      ast.Position
+	Keyword  string
+	Expected []string
+}
+
+func (e *InvalidArgType) Error() string {
+	return describe(fmt.Sprintf("Invalid argument type: %q", e.Keyword), e.Pos, e.Expected)
+}
+
+func (e *InvalidArgType) Code() Code               { return InvalidArgTypeCode }
+func (e *InvalidArgType) Position() ast.Position   { return e.Pos }
+func (e *InvalidArgType) ExpectedTokens() []string { return e.Expected }
+
+type InvalidArgStyle struct {
+	Pos      ast.Position
+	Keyword  string
+	Expected []string
+}
+
+func (e *InvalidArgStyle) Error() string {
+	return describe(fmt.Sprintf("Invalid argument style: %q", e.Keyword), e.Pos, e.Expected)
+}
+
+func (e *InvalidArgStyle) Code() Code               { return InvalidArgStyleCode }
+func (e *InvalidArgStyle) Position() ast.Position   { return e.Pos }
+func (e *InvalidArgStyle) ExpectedTokens() []string { return e.Expected }
+
+type InvalidPluralKey struct {
+	Pos      ast.Position
+	Key      string
+	Expected []string
+}
+
+func (e *InvalidPluralKey) Error() string {
+	return describe(fmt.Sprintf("Invalid plural key: %q", e.Key), e.Pos, e.Expected)
+}
+
+func (e *InvalidPluralKey) Code() Code               { return InvalidPluralKeyCode }
+func (e *InvalidPluralKey) Position() ast.Position   { return e.Pos }
+func (e *InvalidPluralKey) ExpectedTokens() []string { return e.Expected }
+
+// InvalidPluralOffset is reported for plural offsets too large to be
+// represented, such as "offset:99999999999".
+type InvalidPluralOffset struct {
+	Pos      ast.Position
+	Offset   string
+	Expected []string
+}
+
+func (e *InvalidPluralOffset) Error() string {
+	return describe(fmt.Sprintf("Invalid plural offset: %q", e.Offset), e.Pos, e.Expected)
+}
+
+func (e *InvalidPluralOffset) Code() Code               { return InvalidPluralOffsetCode }
+func (e *InvalidPluralOffset) Position() ast.Position   { return e.Pos }
+func (e *InvalidPluralOffset) ExpectedTokens() []string { return e.Expected }
+
+// Snippet renders the line of pattern where err occurred followed by a
+// caret pointing at the offending column. Wrapped errors are unwrapped to
+// find the position, and errors without one are rendered as just their
+// message.
+func Snippet(pattern string, err error) string {
+	var e Error
+	if !stderrors.As(err, &e) {
+		return err.Error()
+	}
+
+	pos := e.Position()
+	lines := strings.Split(pattern, "\n")
+	if pos.Line < 1 || pos.Line > len(lines) {
+		return err.Error()
+	}
+	line := strings.TrimSuffix(lines[pos.Line-1], "\r")
+
+	var b strings.Builder
+	b.WriteString(err.Error())
+	b.WriteString("\n")
+	b.WriteString(line)
+	b.WriteString("\n")
+	column := 1
+	for _, ch := range line {
+		if column >= pos.RuneColumn {
+			break
+		}
+		column++
+		if ch == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	b.WriteRune('^')
+	return b.String()
+}
+
+func describe(msg string, pos ast.Position, expected []string) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	if pos.Line > 0 {
+		fmt.Fprintf(&b, " at line %d, column %d", pos.Line, pos.RuneColumn)
+	}
+	if len(expected) > 0 {
+		b.WriteString("; expected ")
+		for i, token := range expected {
+			switch {
+			case i == 0:
+			case i == len(expected)-1:
+				b.WriteString(" or ")
+			default:
+				b.WriteString(", ")
+			}
+			if utf8.RuneCountInString(token) == 1 {
+				fmt.Fprintf(&b, "%q", token)
+			} else {
+				b.WriteString(token)
+			}
+		}
+	}
+	return b.String()
 }
@@ -0,0 +1,95 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sjansen/messageformat/ast"
+)
+
+func TestError(t *testing.T) {
+	require := require.New(t)
+
+	var err error = &UnexpectedToken{
+		Pos:      ast.Position{Line: 2, ByteColumn: 4, RuneColumn: 3},
+		Token:    "!",
+		Expected: []string{"}", ",", "argument type"},
+	}
+	require.Equal(
+		`Unexpected token: "!" at line 2, column 3; expected "}", "," or argument type`,
+		err.Error(),
+	)
+
+	err = fmt.Errorf("wrapped: %w", err)
+
+	var token *UnexpectedToken
+	require.True(stderrors.As(err, &token))
+	require.Equal("!", token.Token)
+
+	var generic Error
+	require.True(stderrors.As(err, &generic))
+	require.Equal(UnexpectedTokenCode, generic.Code())
+	require.Equal(2, generic.Position().Line)
+	require.Equal([]string{"}", ",", "argument type"}, generic.ExpectedTokens())
+
+	var eof *UnexpectedEOF
+	require.False(stderrors.As(err, &eof))
+}
+
+func TestSnippet(t *testing.T) {
+	for idx, tc := range []struct {
+		pattern  string
+		err      error
+		expected string
+	}{{
+		"Olá, {name!}",
+		&UnexpectedToken{
+			Pos:   ast.Position{Line: 1, ByteColumn: 12, RuneColumn: 11},
+			Token: "!",
+		},
+		"Unexpected token: \"!\" at line 1, column 11\n" +
+			"Olá, {name!}\n" +
+			"          ^",
+	}, {
+		"first\n\t{n, plural, lots{#}}",
+		&InvalidPluralKey{
+			Pos: ast.Position{Line: 2, ByteColumn: 14, RuneColumn: 14},
+			Key: "lots",
+		},
+		"Invalid plural key: \"lots\" at line 2, column 14\n" +
+			"\t{n, plural, lots{#}}\n" +
+			"\t            ^",
+	}, {
+		"{n",
+		&UnterminatedArgument{
+			Pos:   ast.Position{Line: 1, ByteColumn: 3, RuneColumn: 3},
+			Begin: ast.Position{Line: 1, ByteColumn: 1, RuneColumn: 1},
+		},
+		"Unterminated argument (opened at line 1, column 1) at line 1, column 3\n" +
+			"{n\n" +
+			"  ^",
+	}, {
+		"{n, money}",
+		fmt.Errorf("greeting: %w", &InvalidArgType{
+			Pos:     ast.Position{Line: 1, ByteColumn: 5, RuneColumn: 5},
+			Keyword: "money",
+		}),
+		"greeting: Invalid argument type: \"money\" at line 1, column 5\n" +
+			"{n, money}\n" +
+			"    ^",
+	}, {
+		"Spoon!",
+		stderrors.New("not a parse error"),
+		"not a parse error",
+	}} {
+		tc := tc
+		t.Run(fmt.Sprint(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(tc.expected, Snippet(tc.pattern, tc.err))
+		})
+	}
+}
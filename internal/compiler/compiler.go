@@ -12,13 +12,43 @@ func Compile(lang string, msg *ast.Message) (*Message, error) {
 	if err != nil {
 		return nil, err
 	}
-	return compile(tag, msg, nil)
+	compiled, err := compile(tag, msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	compiled.named = hasNamedArguments(msg)
+	return compiled, nil
+}
+
+func hasNamedArguments(msg *ast.Message) bool {
+	for _, part := range msg.Parts {
+		if arg, ok := part.(ast.Argument); ok {
+			if _, ok := ast.ArgNumber(arg.ArgNameOrNumber()); !ok {
+				return true
+			}
+		}
+		var messages map[string]*ast.Message
+		switch x := part.(type) {
+		case *ast.PluralArg:
+			messages = x.Messages
+		case *ast.SelectArg:
+			messages = x.Messages
+		}
+		for _, msg := range messages {
+			if hasNamedArguments(msg) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func compile(lang language.Tag, msg *ast.Message, n *numberSign) (*Message, error) {
 	parts := make([]part, 0, len(msg.Parts))
 	for _, part := range msg.Parts {
 		switch x := part.(type) {
+		case *ast.BadArg:
+			return nil, x.Err
 		case *ast.NumberSign:
 			if n == nil {
 				return nil, fmt.Errorf("illegal NumberSign")
@@ -42,12 +72,20 @@ func compile(lang language.Tag, msg *ast.Message, n *numberSign) (*Message, erro
 				return nil, err
 			}
 			parts = append(parts, tmp)
+		case *ast.SimpleArg:
+			tmp, err := newSimpleArg(lang, x)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, tmp)
 		case *ast.Text:
 			tmp, err := newText(lang, x)
 			if err != nil {
 				return nil, err
 			}
 			parts = append(parts, tmp)
+		default:
+			return nil, fmt.Errorf("unsupported part: %T", part)
 		}
 	}
 	return &Message{lang: lang, parts: parts}, nil
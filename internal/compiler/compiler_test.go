@@ -1,7 +1,11 @@
 package compiler
 
 import (
+	"math"
+	"math/big"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -63,6 +67,26 @@ var elves = &ast.Message{Parts: []ast.Part{
 		}},
 }}
 
+var others = &ast.Message{Parts: []ast.Part{
+	&ast.PluralArg{
+		ArgID:  "count",
+		Offset: 1,
+		Messages: map[string]*ast.Message{
+			"=0": {Parts: []ast.Part{&ast.Text{Value: "Nobody liked this."}}},
+			"=1": {Parts: []ast.Part{&ast.Text{Value: "You liked this."}}},
+			"one": {Parts: []ast.Part{
+				&ast.Text{Value: "You and "},
+				&ast.NumberSign{},
+				&ast.Text{Value: " other person liked this."},
+			}},
+			"other": {Parts: []ast.Part{
+				&ast.Text{Value: "You and "},
+				&ast.NumberSign{},
+				&ast.Text{Value: " others liked this."},
+			}},
+		}},
+}}
+
 func TestCompileAndFormat(t *testing.T) {
 	require := require.New(t)
 
@@ -113,6 +137,22 @@ func TestCompileAndFormat(t *testing.T) {
 		elves, map[string]interface{}{
 			"count": 2,
 		},
+	}, {`Nobody liked this.`,
+		others, map[string]interface{}{
+			"count": 0,
+		},
+	}, {`You liked this.`,
+		others, map[string]interface{}{
+			"count": 1,
+		},
+	}, {`You and 1 other person liked this.`,
+		others, map[string]interface{}{
+			"count": 2,
+		},
+	}, {`You and 2 others liked this.`,
+		others, map[string]interface{}{
+			"count": 3,
+		},
 	}} {
 		compiled, err := Compile("en", tc.message)
 		require.NoError(err)
@@ -122,3 +162,562 @@ func TestCompileAndFormat(t *testing.T) {
 		require.Equal(tc.expected, actual)
 	}
 }
+
+func TestCompileAndFormatPluralValues(t *testing.T) {
+	hours := &ast.Message{Parts: []ast.Part{
+		&ast.PluralArg{
+			ArgID: "n",
+			Messages: map[string]*ast.Message{
+				"=0": {Parts: []ast.Part{&ast.Text{Value: "no time"}}},
+				"one": {Parts: []ast.Part{
+					&ast.NumberSign{},
+					&ast.Text{Value: " hour"},
+				}},
+				"other": {Parts: []ast.Part{
+					&ast.NumberSign{},
+					&ast.Text{Value: " hours"},
+				}},
+			}},
+	}}
+	for idx, tc := range []struct {
+		lang     string
+		value    interface{}
+		expected string
+	}{
+		{"en", 1, "1 hour"},
+		{"en", int64(1), "1 hour"},
+		{"en", uint8(1), "1 hour"},
+		{"en", 1.0, "1 hour"},
+		{"en", "1.0", "1.0 hours"},
+		{"en", 1.5, "1.5 hours"},
+		{"en", float32(2.25), "2.25 hours"},
+		{"en", "0.0", "no time"},
+		{"en", int64(9007199254740993), "9,007,199,254,740,993 hours"},
+		{"en", "12345678901234567890", "12,345,678,901,234,567,890 hours"},
+		{"fr", 1.5, "1,5 hour"},
+		{"fr", "0.50", "0,50 hour"},
+		{"de", 12345, "12.345 hours"},
+		{"ar", 3, "٣ hours"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile(tc.lang, hours)
+			require.NoError(err)
+
+			actual, err := compiled.Format(map[string]interface{}{"n": tc.value})
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+type count uint16
+
+func TestCompileAndFormatSimpleArg(t *testing.T) {
+	for idx, tc := range []struct {
+		lang      string
+		expected  string
+		message   *ast.Message
+		arguments map[string]interface{}
+	}{{
+		"en", "1,234.5",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType},
+		}},
+		map[string]interface{}{"n": 1234.5},
+	}, {
+		"de", "1.234,5",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType},
+		}},
+		map[string]interface{}{"n": 1234.5},
+	}, {
+		"en", "-1,235",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.IntegerStyle},
+		}},
+		map[string]interface{}{"n": -1234.6},
+	}, {
+		"en", "Done: 25%",
+		&ast.Message{Parts: []ast.Part{
+			&ast.Text{Value: "Done: "},
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.PercentStyle},
+		}},
+		map[string]interface{}{"n": 0.25},
+	}, {
+		"fr", "12\u00a0%",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.PercentStyle},
+		}},
+		map[string]interface{}{"n": float32(0.125)},
+	}, {
+		"en", "$1,234.50",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.CurrencyStyle},
+		}},
+		map[string]interface{}{"n": 1234.5},
+	}, {
+		"de", "1.234,00\u00a0€",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.CurrencyStyle},
+		}},
+		map[string]interface{}{"n": uint16(1234)},
+	}, {
+		"en", "€1,234.50",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "currency/EUR .00"},
+		}},
+		map[string]interface{}{"n": 1234.5},
+	}, {
+		"de", "1.234,50\u00a0$",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "currency/EUR .00"},
+		}},
+		map[string]interface{}{"n": Amount{Value: 1234.5, ISOCode: "USD"}},
+	}, {
+		"en", "+1.2M / 12.5%",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "a", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "compact-short sign-always"},
+			&ast.Text{Value: " / "},
+			&ast.SimpleArg{ArgID: "b", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "percent scale/100 .0"},
+		}},
+		map[string]interface{}{"a": 1234567, "b": 0.125},
+	}, {
+		"de", "1.234,50 / (0,25)",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "a", ArgType: ast.NumberType, ArgStyle: ast.TextStyle, StyleText: "#,##0.00"},
+			&ast.Text{Value: " / "},
+			&ast.SimpleArg{ArgID: "b", ArgType: ast.NumberType, ArgStyle: ast.TextStyle, StyleText: "0.00;(0.00)"},
+		}},
+		map[string]interface{}{"a": 1234.5, "b": -0.25},
+	}, {
+		"en", "USD 5.00 / CHF 7.50",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "a", ArgType: ast.NumberType, ArgStyle: ast.TextStyle, StyleText: "¤¤ #,##0.00"},
+			&ast.Text{Value: " / "},
+			&ast.SimpleArg{ArgID: "b", ArgType: ast.NumberType, ArgStyle: ast.TextStyle, StyleText: "¤¤ #,##0.00"},
+		}},
+		map[string]interface{}{"a": 5, "b": Amount{Value: "7.5", ISOCode: "CHF"}},
+	}, {
+		"en", "12 / 255 / 98,765,432,109,876,543,210",
+		&ast.Message{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "a", ArgType: ast.NumberType},
+			&ast.Text{Value: " / "},
+			&ast.SimpleArg{ArgID: "b", ArgType: ast.NumberType},
+			&ast.Text{Value: " / "},
+			&ast.SimpleArg{ArgID: "c", ArgType: ast.NumberType},
+		}},
+		map[string]interface{}{
+			"a": count(12),
+			"b": uint8(255),
+			"c": func() *big.Int {
+				n, _ := new(big.Int).SetString("98765432109876543210", 10)
+				return n
+			}(),
+		},
+	}} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile(tc.lang, tc.message)
+			require.NoError(err)
+
+			actual, err := compiled.Format(tc.arguments)
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestCompileAndFormatDateTime(t *testing.T) {
+	at := time.Date(2020, time.March, 7, 15, 4, 5, 0, time.UTC)
+	for idx, tc := range []struct {
+		lang     string
+		argType  ast.ArgType
+		style    ast.ArgStyle
+		pattern  string
+		value    interface{}
+		expected string
+	}{
+		{"en", ast.DateType, ast.DefaultStyle, "", at, "Mar 7, 2020"},
+		{"en", ast.DateType, ast.ShortStyle, "", &at, "3/7/20"},
+		{"en", ast.DateType, ast.FullStyle, "", at.Unix(), "Saturday, March 7, 2020"},
+		{"en", ast.TimeType, ast.DefaultStyle, "", float64(at.Unix()) + 0.5, "3:04:05 PM"},
+		{"en", ast.TimeType, ast.ShortStyle, "", uint32(at.Unix()), "3:04 PM"},
+		{"de", ast.DateType, ast.LongStyle, "", at, "7. März 2020"},
+		{"de", ast.TimeType, ast.MediumStyle, "", at, "15:04:05"},
+		{"pt-BR", ast.DateType, ast.FullStyle, "", at, "sábado, 7 de março de 2020"},
+		{"ja", ast.DateType, ast.LongStyle, "", at.In(time.FixedZone("JST", 9*3600)), "2020年3月8日"},
+		{"en", ast.DateType, ast.SkeletonStyle, "yMMMd", at, "Mar 7, 2020"},
+		{"de", ast.DateType, ast.SkeletonStyle, "MMMMEEEEd", at, "Samstag, 7. März"},
+		{"en", ast.TimeType, ast.SkeletonStyle, "jmm", at, "3:04 PM"},
+		{"fr", ast.TimeType, ast.SkeletonStyle, "yMdjmm", at, "07/03/2020 15:04"},
+		{"en", ast.DateType, ast.TextStyle, "yyyy-MM-dd", at, "2020-03-07"},
+		{"de", ast.TimeType, ast.TextStyle, "EEEE 'um' HH:mm", at, "Samstag um 15:04"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			arg := &ast.SimpleArg{ArgID: "d", ArgType: tc.argType, ArgStyle: tc.style}
+			switch tc.style {
+			case ast.SkeletonStyle:
+				arg.Skeleton = tc.pattern
+			case ast.TextStyle:
+				arg.StyleText = tc.pattern
+			}
+			compiled, err := Compile(tc.lang, &ast.Message{Parts: []ast.Part{arg}})
+			require.NoError(err)
+
+			actual, err := compiled.Format(map[string]interface{}{"d": tc.value})
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+
+	compiled, err := Compile("en", &ast.Message{Parts: []ast.Part{
+		&ast.SimpleArg{ArgID: "d", ArgType: ast.DateType},
+	}})
+	require.NoError(t, err)
+	for _, value := range []interface{}{"2020-03-07", (*time.Time)(nil), math.NaN()} {
+		_, err = compiled.Format(map[string]interface{}{"d": value})
+		require.Error(t, err)
+	}
+}
+
+func TestCompileAndFormatDateTimeOptions(t *testing.T) {
+	require := require.New(t)
+
+	tokyo := time.FixedZone("JST", 9*3600)
+	at := time.Date(2020, time.March, 7, 15, 4, 5, 0, time.UTC)
+	compiled, err := Compile("en", &ast.Message{Parts: []ast.Part{
+		&ast.SimpleArg{ArgID: "d", ArgType: ast.DateType, ArgStyle: ast.TextStyle, StyleText: "MMM d, HH:mm z"},
+	}})
+	require.NoError(err)
+
+	actual, err := compiled.Format(map[string]interface{}{"d": at.Unix()})
+	require.NoError(err)
+	require.Equal("Mar 7, 15:04 UTC", actual)
+
+	inTokyo := compiled.WithOptions(Options{Location: tokyo})
+	actual, err = inTokyo.Format(map[string]interface{}{"d": at.Unix()})
+	require.NoError(err)
+	require.Equal("Mar 8, 00:04 JST", actual)
+
+	actual, err = inTokyo.Format(map[string]interface{}{"d": at.In(time.FixedZone("PST", -8*3600))})
+	require.NoError(err)
+	require.Equal("Mar 8, 00:04 JST", actual)
+
+	fixed := compiled.WithOptions(Options{Now: func() time.Time { return at }})
+	actual, err = fixed.Format(map[string]interface{}{"d": Now})
+	require.NoError(err)
+	require.Equal("Mar 7, 15:04 UTC", actual)
+	require.Equal(Options{}, compiled.Options())
+}
+
+func TestCompileAndFormatOrdinal(t *testing.T) {
+	ordinal := &ast.Message{Parts: []ast.Part{
+		&ast.SimpleArg{ArgID: "n", ArgType: ast.OrdinalType},
+		&ast.Text{Value: " item"},
+	}}
+	for idx, tc := range []struct {
+		lang     string
+		value    interface{}
+		expected string
+	}{
+		{"en", 1, "1st item"},
+		{"en", 2, "2nd item"},
+		{"en", 3, "3rd item"},
+		{"en", 4, "4th item"},
+		{"en", int64(111), "111th item"},
+		{"fr", 1, "1er item"},
+		{"de", uint(2), "2. item"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile(tc.lang, ordinal)
+			require.NoError(err)
+
+			actual, err := compiled.Format(map[string]interface{}{"n": tc.value})
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestCompileAndFormatSpellout(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		ruleSet  string
+		value    interface{}
+		expected string
+	}{
+		{"en", "", 42, "forty-two"},
+		{"en", "", uint8(7), "seven"},
+		{"en", "", -1.5, "minus one point five"},
+		{"en", "%spellout-ordinal", 42, "forty-second"},
+		{"de", "", int64(1999), "eintausendneunhundertneunundneunzig"},
+		{"es", "%spellout-ordinal", 3, "tercero"},
+		{"fr", "", 80, "quatre-vingts"},
+		{"pt-BR", "", 1001, "mil e um"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			arg := &ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType}
+			if tc.ruleSet != "" {
+				arg.ArgStyle = ast.TextStyle
+				arg.StyleText = tc.ruleSet
+			}
+			compiled, err := Compile(tc.lang, &ast.Message{Parts: []ast.Part{arg}})
+			require.NoError(err)
+
+			actual, err := compiled.Format(map[string]interface{}{"n": tc.value})
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+
+	compiled, err := Compile("en", &ast.Message{Parts: []ast.Part{
+		&ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType},
+	}})
+	require.NoError(t, err)
+	for _, value := range []interface{}{"forty", uint64(math.MaxUint64)} {
+		_, err = compiled.Format(map[string]interface{}{"n": value})
+		require.Error(t, err)
+	}
+}
+
+func TestCompileAndFormatDuration(t *testing.T) {
+	for idx, tc := range []struct {
+		style    ast.ArgStyle
+		value    interface{}
+		expected string
+	}{
+		{ast.DefaultStyle, time.Hour + 2*time.Minute + 3*time.Second, "1:02:03"},
+		{ast.DefaultStyle, 3723, "1:02:03"},
+		{ast.DefaultStyle, 90.4, "1:30"},
+		{ast.DefaultStyle, 1500 * time.Millisecond, "0:02"},
+		{ast.LongStyle, 3723 * time.Second, "1 hour, 2 minutes, 3 seconds"},
+		{ast.ShortStyle, uint16(120), "2 min"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile("en", &ast.Message{Parts: []ast.Part{
+				&ast.SimpleArg{ArgID: "d", ArgType: ast.DurationType, ArgStyle: tc.style},
+			}})
+			require.NoError(err)
+
+			actual, err := compiled.Format(map[string]interface{}{"d": tc.value})
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+
+	compiled, err := Compile("en", &ast.Message{Parts: []ast.Part{
+		&ast.SimpleArg{ArgID: "d", ArgType: ast.DurationType},
+	}})
+	require.NoError(t, err)
+	for _, value := range []interface{}{"soon", (*time.Duration)(nil), "1e30"} {
+		_, err = compiled.Format(map[string]interface{}{"d": value})
+		require.Error(t, err)
+	}
+}
+
+type Greeting struct {
+	Name     string `mf:"name"`
+	TimeSpan string `mf:"timespan"`
+	Ignored  string `mf:"-"`
+}
+
+type Invitation struct {
+	Greeting
+	Name  string `mf:"name,omitempty"`
+	Count int    `mf:"count"`
+}
+
+type Deep struct{ Name string }
+
+type Middle struct{ Deep }
+
+type Shallow struct{ Name string }
+
+type Outer struct {
+	Middle
+	Shallow
+}
+
+type Tagged struct {
+	Title string `mf:"Name"`
+}
+
+type Ambiguous struct {
+	Deep
+	Shallow
+}
+
+var plainName = &ast.Message{Parts: []ast.Part{
+	&ast.PlainArg{ArgID: "Name"},
+}}
+
+func TestFormatStruct(t *testing.T) {
+	for idx, tc := range []struct {
+		expected  string
+		message   *ast.Message
+		arguments interface{}
+	}{
+		{"Boa tarde, Alice.", hello,
+			Greeting{Name: "Alice", TimeSpan: "afternoon"}},
+		{"Boa noite, Bob.", hello,
+			&Greeting{Name: "Bob", TimeSpan: "evening"}},
+		{"Bom dia, Eve.", hello,
+			Invitation{Greeting: Greeting{Name: "Mallory", TimeSpan: "other"}, Name: "Eve"}},
+		{"You and 2 others liked this.", others,
+			&Invitation{Count: 3}},
+		{"shallow", plainName,
+			Outer{Middle{Deep{Name: "deep"}}, Shallow{Name: "shallow"}}},
+		{"pointer", plainName,
+			struct{ *Shallow }{&Shallow{Name: "pointer"}}},
+		{"tagged", plainName,
+			struct {
+				Shallow
+				Tagged
+			}{Shallow{Name: "shallow"}, Tagged{Title: "tagged"}}},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile("en", tc.message)
+			require.NoError(err)
+
+			actual, err := compiled.Format(tc.arguments)
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestFormatStructErrors(t *testing.T) {
+	for idx, tc := range []struct {
+		message   *ast.Message
+		arguments interface{}
+	}{
+		{hello, struct{ Name string }{"Alice"}},
+		{hello, struct {
+			Name     string
+			timespan string
+		}{"Alice", "other"}},
+		{hello, struct {
+			Name     string
+			TimeSpan string `mf:"-"`
+		}{"Alice", "other"}},
+		{hello, (*Greeting)(nil)},
+		{plainName, Ambiguous{Deep{Name: "deep"}, Shallow{Name: "shallow"}}},
+		{plainName, struct {
+			Deep
+			Shallow
+			Middle
+		}{Middle: Middle{Deep{Name: "deeper"}}}},
+		{plainName, struct{ *Shallow }{}},
+		{hello, []string{"Alice"}},
+		{hello, 42},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			compiled, err := Compile("en", tc.message)
+			require.NoError(err)
+
+			_, err = compiled.Format(tc.arguments)
+			require.Error(err)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for idx, tc := range []*ast.Message{
+		{Parts: []ast.Part{
+			&ast.NumberSign{},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.InvalidType},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.ShortStyle},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.DateType, ArgStyle: ast.PercentStyle},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.DurationType, ArgStyle: ast.CurrencyStyle},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.OrdinalType, ArgStyle: ast.PercentStyle},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType, ArgStyle: ast.ShortStyle},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType, ArgStyle: ast.TextStyle, StyleText: "%%th"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.SkeletonStyle, Skeleton: "precision-fancy"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.DateType, ArgStyle: ast.SkeletonStyle, Skeleton: "yMMMW"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.NumberType, ArgStyle: ast.TextStyle, StyleText: "0#.00"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.DateType, ArgStyle: ast.TextStyle, StyleText: "yyyy-ww"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.DurationType, ArgStyle: ast.TextStyle, StyleText: "hh:mm"},
+		}},
+		{Parts: []ast.Part{
+			&ast.SimpleArg{ArgID: "n", ArgType: ast.OrdinalType, ArgStyle: ast.SkeletonStyle, Skeleton: ".00"},
+		}},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			_, err := Compile("en", tc)
+			require.Error(err)
+		})
+	}
+}
+
+func TestCompileUnsupportedLocale(t *testing.T) {
+	for idx, tc := range []struct {
+		lang string
+		arg  *ast.SimpleArg
+	}{
+		{"ko", &ast.SimpleArg{ArgID: "d", ArgType: ast.DateType}},
+		{"tr", &ast.SimpleArg{ArgID: "d", ArgType: ast.TimeType, ArgStyle: ast.ShortStyle}},
+		{"hi", &ast.SimpleArg{ArgID: "d", ArgType: ast.DateType, ArgStyle: ast.SkeletonStyle, Skeleton: "yMMMd"}},
+		{"ja", &ast.SimpleArg{ArgID: "n", ArgType: ast.SpelloutType}},
+		{"ko", &ast.SimpleArg{ArgID: "d", ArgType: ast.DurationType, ArgStyle: ast.LongStyle}},
+		{"tr", &ast.SimpleArg{ArgID: "d", ArgType: ast.DurationType, ArgStyle: ast.ShortStyle}},
+		{"ko", &ast.SimpleArg{ArgID: "n", ArgType: ast.OrdinalType}},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			_, err := Compile(tc.lang, &ast.Message{Parts: []ast.Part{tc.arg}})
+			require.Error(err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
+)
+
+// Amount is a value in the currency identified by ISOCode, such as "EUR".
+type Amount struct {
+	Value   interface{}
+	ISOCode string
+}
+
+func newCurrencyFormatter(lang language.Tag) formatter {
+	format := func(lang language.Tag, unit currency.Unit) *number.Format {
+		return number.Currency(lang, unit, number.SymbolDisplay)
+	}
+	return newCurrencyPatternFormatter(format, number.DefaultCurrency(lang))
+}
+
+// newCurrencyPatternFormatter formats amounts in the currency of their
+// value, or in the fallback currency, using the format returned by format.
+func newCurrencyPatternFormatter(format func(language.Tag, currency.Unit) *number.Format, fallback currency.Unit) formatter {
+	formats := &currencyFormats{build: format}
+	return func(w writer, env *env, value interface{}) error {
+		unit, value, err := currencyAmount(value, fallback)
+		if err != nil {
+			return err
+		}
+		d, err := decimal.New(value)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(formats.get(env.lang, unit).Format(d))
+		return err
+	}
+}
+
+// currencyFormats remembers the formats built for each language and
+// currency, as looking up symbols is too slow to repeat for every value.
+type currencyFormats struct {
+	build   func(language.Tag, currency.Unit) *number.Format
+	formats sync.Map // map[currencyKey]*number.Format
+}
+
+type currencyKey struct {
+	lang language.Tag
+	unit currency.Unit
+}
+
+func (c *currencyFormats) get(lang language.Tag, unit currency.Unit) *number.Format {
+	key := currencyKey{lang, unit}
+	if f, ok := c.formats.Load(key); ok {
+		return f.(*number.Format)
+	}
+	f, _ := c.formats.LoadOrStore(key, c.build(lang, unit))
+	return f.(*number.Format)
+}
+
+// currencyAmount splits value into a currency and a number. Plain numbers
+// are in the fallback currency.
+func currencyAmount(value interface{}, fallback currency.Unit) (currency.Unit, interface{}, error) {
+	switch x := value.(type) {
+	case currency.Amount:
+		return x.Currency(), amountValue(x), nil
+	case *currency.Amount:
+		if x != nil {
+			return x.Currency(), amountValue(*x), nil
+		}
+	case Amount:
+		unit, err := currency.ParseISO(x.ISOCode)
+		if err != nil {
+			return currency.Unit{}, nil, fmt.Errorf("invalid currency code: %q", x.ISOCode)
+		}
+		return unit, x.Value, nil
+	case *Amount:
+		if x != nil {
+			return currencyAmount(*x, fallback)
+		}
+	default:
+		return fallback, value, nil
+	}
+	return currency.Unit{}, nil, fmt.Errorf("expected number got: %T", value)
+}
+
+// amountValue recovers the number in a. currency.Amount does not export
+// it, but prints it unrounded after the ISO code when given a precision.
+func amountValue(a currency.Amount) string {
+	s := fmt.Sprintf("%.0v", a)
+	return strings.TrimPrefix(s, a.Currency().String()+" ")
+}
@@ -0,0 +1,141 @@
+package compiler
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/datetime"
+)
+
+// Now is a date or time value that stands for the current time, as
+// reported by Options.Now.
+var Now = now{}
+
+type now struct{}
+
+var dateTimeWidths = map[ast.ArgStyle]datetime.Width{
+	ast.DefaultStyle: datetime.Medium,
+	ast.FullStyle:    datetime.Full,
+	ast.LongStyle:    datetime.Long,
+	ast.MediumStyle:  datetime.Medium,
+	ast.ShortStyle:   datetime.Short,
+}
+
+func newDateFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	switch arg.ArgStyle {
+	case ast.SkeletonStyle:
+		return newSkeletonFormatter(lang, arg.Skeleton)
+	case ast.TextStyle:
+		return newCustomPatternFormatter(lang, arg.StyleText)
+	}
+	width, ok := dateTimeWidths[arg.ArgStyle]
+	if !ok {
+		return nil, unsupportedStyle(arg)
+	}
+	loc, err := datetime.LocaleFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	return newPatternFormatter(loc, loc.DatePattern(width)), nil
+}
+
+func newTimeFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	switch arg.ArgStyle {
+	case ast.SkeletonStyle:
+		return newSkeletonFormatter(lang, arg.Skeleton)
+	case ast.TextStyle:
+		return newCustomPatternFormatter(lang, arg.StyleText)
+	}
+	width, ok := dateTimeWidths[arg.ArgStyle]
+	if !ok {
+		return nil, unsupportedStyle(arg)
+	}
+	loc, err := datetime.LocaleFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	return newPatternFormatter(loc, loc.TimePattern(width)), nil
+}
+
+func newSkeletonFormatter(lang language.Tag, skeleton string) (formatter, error) {
+	loc, err := datetime.LocaleFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	p, err := loc.SkeletonPattern(skeleton)
+	if err != nil {
+		return nil, err
+	}
+	return newPatternFormatter(loc, p), nil
+}
+
+func newCustomPatternFormatter(lang language.Tag, pattern string) (formatter, error) {
+	loc, err := datetime.LocaleFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	p, err := datetime.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return newPatternFormatter(loc, p), nil
+}
+
+func newPatternFormatter(loc *datetime.Locale, p *datetime.Pattern) formatter {
+	return func(w writer, env *env, value interface{}) error {
+		t, err := toTime(value, env.options)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(p.Format(loc, t))
+		return err
+	}
+}
+
+// toTime converts a time.Time, *time.Time, Now or Unix timestamp in
+// seconds to a time in the location of options. Without a location,
+// timestamps are interpreted in UTC.
+func toTime(value interface{}, options *Options) (time.Time, error) {
+	t, err := valueTime(value, options)
+	if err == nil && options.Location != nil {
+		t = t.In(options.Location)
+	}
+	return t, err
+}
+
+func valueTime(value interface{}, options *Options) (time.Time, error) {
+	switch x := value.(type) {
+	case time.Time:
+		return x, nil
+	case *time.Time:
+		if x != nil {
+			return *x, nil
+		}
+	case now:
+		if options.Now != nil {
+			return options.Now(), nil
+		}
+		return time.Now(), nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return time.Unix(v.Int(), 0).UTC(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() <= math.MaxInt64 {
+			return time.Unix(int64(v.Uint()), 0).UTC(), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			sec, frac := math.Modf(f)
+			return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected time got: %T", value)
+}
@@ -0,0 +1,68 @@
+package compiler
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/datetime"
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+// Durations are numeric by default. The long and full styles spell out
+// units, and the short style abbreviates them.
+var durationStyles = map[ast.ArgStyle]datetime.DurationStyle{
+	ast.DefaultStyle: datetime.NumericDuration,
+	ast.MediumStyle:  datetime.NumericDuration,
+	ast.FullStyle:    datetime.LongDuration,
+	ast.LongStyle:    datetime.LongDuration,
+	ast.ShortStyle:   datetime.ShortDuration,
+}
+
+func newDurationFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	durationStyle, ok := durationStyles[arg.ArgStyle]
+	if !ok {
+		return nil, unsupportedStyle(arg)
+	}
+	f, err := datetime.NewDuration(lang, durationStyle)
+	if err != nil {
+		return nil, err
+	}
+	return func(w writer, env *env, value interface{}) error {
+		seconds, err := toSeconds(value)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(f.Format(seconds))
+		return err
+	}, nil
+}
+
+// maxDurationDigits keeps whole seconds within an int64.
+const maxDurationDigits = 18
+
+// toSeconds converts a time.Duration or a number of seconds to a decimal.
+func toSeconds(value interface{}) (decimal.Decimal, error) {
+	var d decimal.Decimal
+	switch x := value.(type) {
+	case time.Duration:
+		d, _ = decimal.New(int64(x))
+		d = d.Shift(-9)
+	case *time.Duration:
+		if x == nil {
+			return d, fmt.Errorf("expected duration got: %T", value)
+		}
+		return toSeconds(*x)
+	default:
+		var err error
+		if d, err = decimal.New(value); err != nil {
+			return d, fmt.Errorf("expected duration got: %T", value)
+		}
+	}
+	if len(d.Int) > maxDurationDigits {
+		return d, fmt.Errorf("duration out of range: %s", d)
+	}
+	return d, nil
+}
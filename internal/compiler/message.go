@@ -1,31 +1,136 @@
 package compiler
 
 import (
+	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 type Message struct {
-	lang  language.Tag
-	parts []part
+	lang    language.Tag
+	parts   []part
+	named   bool
+	options Options
+}
+
+// Options control how dates and times are rendered.
+type Options struct {
+	// Location converts dates and times before they are rendered. If nil,
+	// time.Time values keep their own location and timestamps use UTC.
+	Location *time.Location
+	// Now reports the current time for Now values. If nil, time.Now is
+	// used.
+	Now func() time.Time
+}
+
+// env holds the settings of one call to Format.
+type env struct {
+	lang    language.Tag
+	options *Options
 }
 
 type part interface {
-	format(*strings.Builder, language.Tag, map[string]interface{}) error
+	format(writer, *env, values) error
+}
+
+type writer interface {
+	io.Writer
+	WriteString(string) (int, error)
 }
 
-func (m *Message) Format(arguments map[string]interface{}) (string, error) {
+type appender []byte
+
+func (a *appender) Write(p []byte) (int, error) {
+	*a = append(*a, p...)
+	return len(p), nil
+}
+
+func (a *appender) WriteString(s string) (int, error) {
+	*a = append(*a, s...)
+	return len(s), nil
+}
+
+type stringWriter struct {
+	io.Writer
+}
+
+func (w stringWriter) WriteString(s string) (int, error) {
+	return io.WriteString(w.Writer, s)
+}
+
+func (m *Message) Format(arguments interface{}) (string, error) {
+	values, err := m.newValues(arguments)
+	if err != nil {
+		return "", err
+	}
 	var b strings.Builder
-	if err := m.format(&b, m.lang, arguments); err != nil {
+	if err := m.format(&b, m.env(), values); err != nil {
 		return "", err
 	}
 	return b.String(), nil
 }
 
-func (m *Message) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
+func (m *Message) FormatTo(w io.Writer, arguments interface{}) error {
+	values, err := m.newValues(arguments)
+	if err != nil {
+		return err
+	}
+	sw, ok := w.(writer)
+	if !ok {
+		sw = stringWriter{w}
+	}
+	return m.format(sw, m.env(), values)
+}
+
+func (m *Message) AppendFormat(dst []byte, arguments interface{}) ([]byte, error) {
+	values, err := m.newValues(arguments)
+	if err != nil {
+		return dst, err
+	}
+	a := appender(dst)
+	if err := m.format(&a, m.env(), values); err != nil {
+		return dst, err
+	}
+	return a, nil
+}
+
+func (m *Message) newValues(arguments interface{}) (values, error) {
+	values, err := newValues(arguments)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := values.(sliceValues); ok && m.named {
+		return nil, fmt.Errorf("positional arguments used with named arguments in pattern")
+	}
+	return values, nil
+}
+
+func (m *Message) Language() language.Tag {
+	return m.lang
+}
+
+// Options returns the options of m.
+func (m *Message) Options() Options {
+	return m.options
+}
+
+// WithOptions returns a copy of m that uses options.
+func (m *Message) WithOptions(options Options) *Message {
+	c := *m
+	c.options = options
+	return &c
+}
+
+func (m *Message) env() *env {
+	return &env{lang: m.lang, options: &m.options}
+}
+
+func (m *Message) format(w writer, env *env, arguments values) error {
 	for _, part := range m.parts {
-		if err := part.format(b, lang, arguments); err != nil {
+		if err := part.format(w, env, arguments); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,52 @@
+package compiler
+
+import (
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
+)
+
+func newNumberFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	var f *number.Format
+	switch arg.ArgStyle {
+	case ast.DefaultStyle:
+		f = number.Decimal(lang)
+	case ast.IntegerStyle:
+		f = number.Integer(lang)
+	case ast.PercentStyle:
+		f = number.Percent(lang)
+	case ast.CurrencyStyle:
+		return newCurrencyFormatter(lang), nil
+	case ast.SkeletonStyle:
+		sk, err := number.ParseSkeleton(arg.Skeleton)
+		if err != nil {
+			return nil, err
+		}
+		if unit, ok := sk.Currency(); ok {
+			return newCurrencyPatternFormatter(sk.Format, unit), nil
+		}
+		f = sk.Format(lang, currency.Unit{})
+	case ast.TextStyle:
+		p, err := number.ParsePattern(arg.StyleText)
+		if err != nil {
+			return nil, err
+		}
+		if p.IsCurrency() {
+			return newCurrencyPatternFormatter(p.Format, number.DefaultCurrency(lang)), nil
+		}
+		f = p.Format(lang, currency.Unit{})
+	default:
+		return nil, unsupportedStyle(arg)
+	}
+	return func(w writer, env *env, value interface{}) error {
+		d, err := decimal.New(value)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(f.Format(d))
+		return err
+	}, nil
+}
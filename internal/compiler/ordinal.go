@@ -0,0 +1,31 @@
+package compiler
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
+)
+
+func newOrdinalFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	if arg.ArgStyle != ast.DefaultStyle {
+		return nil, unsupportedStyle(arg)
+	}
+	o, err := number.NewOrdinal(lang)
+	if err != nil {
+		return nil, err
+	}
+	return func(w writer, env *env, value interface{}) error {
+		d, err := decimal.New(value)
+		if err != nil {
+			return err
+		}
+		s, err := o.Format(d)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(s)
+		return err
+	}, nil
+}
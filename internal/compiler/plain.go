@@ -2,29 +2,38 @@ package compiler
 
 import (
 	"fmt"
-	"strings"
+	"reflect"
 
 	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
 	"golang.org/x/text/language"
 )
 
 type plainArg struct {
-	ArgID string
+	ArgID  string
+	number *number.Format
 }
 
 func newPlainArg(lang language.Tag, p *ast.PlainArg) (*plainArg, error) {
-	return &plainArg{ArgID: p.ArgID}, nil
+	return &plainArg{ArgID: p.ArgID, number: number.Decimal(lang)}, nil
 }
 
-func (p *plainArg) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
-	value, ok := arguments[p.ArgID]
+// format writes strings as they are and numbers with the decimal format of
+// the message's language.
+func (p *plainArg) format(w writer, env *env, arguments values) error {
+	value, ok := arguments.lookup(p.ArgID)
 	if !ok {
 		return fmt.Errorf("missing arg: %q", p.ArgID)
 	}
-	str, ok := value.(string)
-	if !ok {
-		return fmt.Errorf("expected string got: %T", value)
+	var str string
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		str = v.String()
+	} else if d, err := decimal.New(value); err == nil {
+		str = p.number.Format(d)
+	} else {
+		return fmt.Errorf("expected string or number got: %T", value)
 	}
-	b.WriteString(str)
-	return nil
+	_, err := w.WriteString(str)
+	return err
 }
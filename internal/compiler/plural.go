@@ -2,18 +2,19 @@ package compiler
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 
 	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
 )
 
 type numberSign struct {
 	ArgID  string
 	Offset int
+	Number *number.Format
 }
 
 type pluralArg struct {
@@ -23,17 +24,21 @@ type pluralArg struct {
 	Messages map[string]*Message
 }
 
-func (n *numberSign) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
-	value, ok := arguments[n.ArgID]
+func (n *numberSign) format(w writer, env *env, arguments values) error {
+	value, ok := arguments.lookup(n.ArgID)
 	if !ok {
 		return fmt.Errorf("missing arg: %q", n.ArgID)
 	}
-	i, ok := value.(int)
-	if !ok {
-		return fmt.Errorf("expected int got: %T", value)
+	d, err := decimal.New(value)
+	if err != nil {
+		return err
 	}
-	b.WriteString(strconv.Itoa(i - n.Offset))
-	return nil
+	// Keep the fraction digits of the value, which select the plural form.
+	f := *n.Number
+	f.MinFracDigits = len(d.Frac)
+	f.MaxFracDigits = len(d.Frac)
+	_, err = w.WriteString(f.Format(d.Sub(int64(n.Offset))))
+	return err
 }
 
 func newPluralArg(lang language.Tag, p *ast.PluralArg) (*pluralArg, error) {
@@ -43,6 +48,7 @@ func newPluralArg(lang language.Tag, p *ast.PluralArg) (*pluralArg, error) {
 	n := &numberSign{
 		ArgID:  p.ArgID,
 		Offset: p.Offset,
+		Number: number.Decimal(lang),
 	}
 	messages := make(map[string]*Message, len(p.Messages))
 	for k, v := range p.Messages {
@@ -60,30 +66,34 @@ func newPluralArg(lang language.Tag, p *ast.PluralArg) (*pluralArg, error) {
 	}, nil
 }
 
-func (p *pluralArg) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
-	value, ok := arguments[p.ArgID]
+func (p *pluralArg) format(w writer, env *env, arguments values) error {
+	value, ok := arguments.lookup(p.ArgID)
 	if !ok {
 		return fmt.Errorf("missing arg: %q", p.ArgID)
 	}
 
-	n, ok := value.(int)
-	if !ok {
-		return fmt.Errorf("expected int got: %T", value)
+	n, err := decimal.New(value)
+	if err != nil {
+		return err
 	}
 
-	category := fmt.Sprintf("=%d", n)
-	if msg, ok := p.Messages[category]; ok {
-		return msg.format(b, lang, arguments)
+	if n.IsInteger() && !n.Neg {
+		category := "=" + n.Int
+		if msg, ok := p.Messages[category]; ok {
+			return msg.format(w, env, arguments)
+		}
 	}
 
+	n = n.Sub(int64(p.Offset))
+
 	var form plural.Form
 	if p.Ordinal {
-		form = plural.Ordinal.MatchPlural(lang, n, 0, 0, 0, 0)
+		form = n.PluralForm(plural.Ordinal, env.lang)
 	} else {
-		form = plural.Cardinal.MatchPlural(lang, n, 0, 0, 0, 0)
+		form = n.PluralForm(plural.Cardinal, env.lang)
 	}
 
-	category = "other"
+	category := "other"
 	switch form {
 	case plural.Zero:
 		category = "zero"
@@ -98,9 +108,9 @@ func (p *pluralArg) format(b *strings.Builder, lang language.Tag, arguments map[
 	}
 
 	if msg, ok := p.Messages[category]; ok {
-		return msg.format(b, lang, arguments)
+		return msg.format(w, env, arguments)
 	}
 
 	msg := p.Messages["other"]
-	return msg.format(b, lang, arguments)
+	return msg.format(w, env, arguments)
 }
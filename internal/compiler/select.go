@@ -2,7 +2,6 @@ package compiler
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/sjansen/messageformat/ast"
 	"golang.org/x/text/language"
@@ -28,8 +27,8 @@ func newSelectArg(lang language.Tag, s *ast.SelectArg) (*selectArg, error) {
 	}, nil
 }
 
-func (s *selectArg) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
-	value, ok := arguments[s.ArgID]
+func (s *selectArg) format(w writer, env *env, arguments values) error {
+	value, ok := arguments.lookup(s.ArgID)
 	if !ok {
 		return fmt.Errorf("missing arg: %q", s.ArgID)
 	}
@@ -41,5 +40,5 @@ func (s *selectArg) format(b *strings.Builder, lang language.Tag, arguments map[
 	if !ok {
 		return fmt.Errorf("unmatched select: %q", value)
 	}
-	return msg.format(b, lang, arguments)
+	return msg.format(w, env, arguments)
 }
@@ -0,0 +1,61 @@
+package compiler
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+)
+
+type simpleArg struct {
+	ArgID     string
+	Formatter formatter
+}
+
+type formatter func(w writer, env *env, value interface{}) error
+
+type formatterFactory func(lang language.Tag, arg *ast.SimpleArg) (formatter, error)
+
+var formatterFactories = map[ast.ArgType]formatterFactory{
+	ast.DateType:     newDateFormatter,
+	ast.DurationType: newDurationFormatter,
+	ast.NumberType:   newNumberFormatter,
+	ast.OrdinalType:  newOrdinalFormatter,
+	ast.SpelloutType: newSpelloutFormatter,
+	ast.TimeType:     newTimeFormatter,
+}
+
+func newSimpleArg(lang language.Tag, s *ast.SimpleArg) (*simpleArg, error) {
+	factory, ok := formatterFactories[s.ArgType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported argument type: %q", s.ArgType.ToKeyword())
+	}
+	f, err := factory(lang, s)
+	if err != nil {
+		return nil, err
+	}
+	return &simpleArg{
+		ArgID:     s.ArgID,
+		Formatter: f,
+	}, nil
+}
+
+func (s *simpleArg) format(w writer, env *env, arguments values) error {
+	value, ok := arguments.lookup(s.ArgID)
+	if !ok {
+		return fmt.Errorf("missing arg: %q", s.ArgID)
+	}
+	return s.Formatter(w, env, value)
+}
+
+func unsupportedStyle(arg *ast.SimpleArg) error {
+	style := arg.ArgStyle.ToKeyword()
+	switch arg.ArgStyle {
+	case ast.SkeletonStyle:
+		style = "::" + arg.Skeleton
+	case ast.TextStyle:
+		style = arg.StyleText
+	}
+	return fmt.Errorf("unsupported argument style for %s: %q", arg.ArgType.ToKeyword(), style)
+}
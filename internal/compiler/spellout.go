@@ -0,0 +1,38 @@
+package compiler
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/spellout"
+)
+
+// newSpelloutFormatter accepts the name of a public rule set, such as
+// "%spellout-ordinal", as its style.
+func newSpelloutFormatter(lang language.Tag, arg *ast.SimpleArg) (formatter, error) {
+	var name string
+	switch arg.ArgStyle {
+	case ast.DefaultStyle:
+	case ast.TextStyle:
+		name = arg.StyleText
+	default:
+		return nil, unsupportedStyle(arg)
+	}
+	s, err := spellout.New(lang, name)
+	if err != nil {
+		return nil, err
+	}
+	return func(w writer, env *env, value interface{}) error {
+		d, err := decimal.New(value)
+		if err != nil {
+			return err
+		}
+		text, err := s.Format(d)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteString(text)
+		return err
+	}, nil
+}
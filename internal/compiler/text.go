@@ -1,8 +1,6 @@
 package compiler
 
 import (
-	"strings"
-
 	"github.com/sjansen/messageformat/ast"
 	"golang.org/x/text/language"
 )
@@ -15,7 +13,7 @@ func newText(lang language.Tag, t *ast.Text) (*text, error) {
 	return &text{Value: t.Value}, nil
 }
 
-func (t *text) format(b *strings.Builder, lang language.Tag, arguments map[string]interface{}) error {
-	b.WriteString(t.Value)
-	return nil
+func (t *text) format(w writer, env *env, arguments values) error {
+	_, err := w.WriteString(t.Value)
+	return err
 }
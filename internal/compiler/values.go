@@ -0,0 +1,191 @@
+package compiler
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/sjansen/messageformat/ast"
+)
+
+type values interface {
+	lookup(id string) (interface{}, bool)
+}
+
+type mapValues map[string]interface{}
+
+func (m mapValues) lookup(id string) (interface{}, bool) {
+	value, ok := m[id]
+	return value, ok
+}
+
+type sliceValues struct {
+	v reflect.Value
+}
+
+func (s sliceValues) lookup(id string) (interface{}, bool) {
+	n, ok := ast.ArgNumber(id)
+	if !ok || n >= s.v.Len() {
+		return nil, false
+	}
+	return s.v.Index(n).Interface(), true
+}
+
+type structValues struct {
+	v    reflect.Value
+	plan fieldPlan
+}
+
+func (s *structValues) lookup(id string) (interface{}, bool) {
+	index, ok := s.plan[id]
+	if !ok {
+		return nil, false
+	}
+	v := s.v
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v.Interface(), true
+}
+
+// fieldPlan maps argument IDs to the index sequence of a struct field.
+type fieldPlan map[string][]int
+
+var fieldPlans sync.Map // map[reflect.Type]fieldPlan
+
+func newValues(arguments interface{}) (values, error) {
+	switch x := arguments.(type) {
+	case nil:
+		return mapValues(nil), nil
+	case map[string]interface{}:
+		return mapValues(x), nil
+	}
+
+	v := reflect.ValueOf(arguments)
+	if k := v.Kind(); k == reflect.Slice || k == reflect.Array {
+		return sliceValues{v: v}, nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("unsupported arguments: nil %T", arguments)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported arguments: %T", arguments)
+	}
+	return &structValues{v: v, plan: planFields(v.Type())}, nil
+}
+
+func planFields(t reflect.Type) fieldPlan {
+	if plan, ok := fieldPlans.Load(t); ok {
+		return plan.(fieldPlan)
+	}
+	plan := buildPlan(t)
+	actual, _ := fieldPlans.LoadOrStore(t, plan)
+	return actual.(fieldPlan)
+}
+
+type planField struct {
+	index  []int
+	tagged bool
+}
+
+type embeddedStruct struct {
+	t     reflect.Type
+	index []int
+}
+
+// buildPlan resolves the exported fields of t the way encoding/json does.
+// Embedded structs, and non-nil pointers to them, are searched breadth
+// first so that shallower fields shadow deeper ones. At the same depth a
+// tagged field wins over untagged ones, and names that are still
+// ambiguous are dropped along with any deeper fields of the same name.
+func buildPlan(t reflect.Type) fieldPlan {
+	plan := fieldPlan{}
+	visited := map[reflect.Type]bool{}
+	current := []embeddedStruct{{t: t}}
+	for len(current) > 0 {
+		var next []embeddedStruct
+		level := map[string][]planField{}
+		for _, s := range current {
+			if visited[s.t] {
+				continue
+			}
+			next = addFields(level, next, s)
+		}
+		for _, s := range current {
+			visited[s.t] = true
+		}
+		for name, fields := range level {
+			if _, ok := plan[name]; ok {
+				continue
+			}
+			plan[name] = dominantField(fields)
+		}
+		current = next
+	}
+	for name, index := range plan {
+		if index == nil {
+			delete(plan, name)
+		}
+	}
+	return plan
+}
+
+// addFields adds the exported fields of s to level and returns next with
+// the structs that s embeds.
+func addFields(level map[string][]planField, next []embeddedStruct, s embeddedStruct) []embeddedStruct {
+	for i := 0; i < s.t.NumField(); i++ {
+		f := s.t.Field(i)
+		tag, tagged := f.Tag.Lookup("mf")
+		if tag == "-" {
+			continue
+		}
+		index := append(append([]int{}, s.index...), i)
+		if f.Anonymous && !tagged {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				next = append(next, embeddedStruct{t: ft, index: index})
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag = strings.Split(tag, ",")[0]; tag != "" {
+			name = tag
+		}
+		level[name] = append(level[name], planField{index: index, tagged: tag != ""})
+	}
+	return next
+}
+
+// dominantField returns the index of the only field, or of the only tagged
+// field, in fields. It returns nil if the name is ambiguous.
+func dominantField(fields []planField) []int {
+	if len(fields) == 1 {
+		return fields[0].index
+	}
+	var dominant []int
+	for _, f := range fields {
+		if f.tagged {
+			if dominant != nil {
+				return nil
+			}
+			dominant = f.index
+		}
+	}
+	return dominant
+}
@@ -0,0 +1,773 @@
+package datetime
+
+// locales is a hand-maintained subset of the CLDR calendar data, keyed by
+// BCP 47 tag. Lookups walk up the parent chain, so "en-AU" uses "en-001".
+var locales = map[string]*Locale{
+	"en": {
+		Months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		MonthsAbbr: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		Days:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		DaysAbbr:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"BC", "AD"},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy",
+		},
+		TimePatterns: [4]string{
+			"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "d E",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, M/d",
+			"MMM":    "LLL",
+			"MMMEd":  "E, MMM d",
+			"MMMMd":  "MMMM d",
+			"MMMd":   "MMM d",
+			"Md":     "M/d",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "M/y",
+			"yMEd":   "E, M/d/y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E, MMM d, y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "MMMM d, y",
+			"yMMMd":  "MMM d, y",
+			"yMd":    "M/d/y",
+		},
+		ZoneNames: map[string][3]string{
+			"Alaska":            {"Alaska Standard Time", "Alaska Daylight Time", "Alaska Time"},
+			"America_Central":   {"Central Standard Time", "Central Daylight Time", "Central Time"},
+			"America_Eastern":   {"Eastern Standard Time", "Eastern Daylight Time", "Eastern Time"},
+			"America_Mountain":  {"Mountain Standard Time", "Mountain Daylight Time", "Mountain Time"},
+			"America_Pacific":   {"Pacific Standard Time", "Pacific Daylight Time", "Pacific Time"},
+			"Atlantic":          {"Atlantic Standard Time", "Atlantic Daylight Time", "Atlantic Time"},
+			"Australia_Eastern": {"Australian Eastern Standard Time", "Australian Eastern Daylight Time", "Eastern Australia Time"},
+			"Brasilia":          {"Brasilia Standard Time", "Brasilia Summer Time", "Brasilia Time"},
+			"China":             {"China Standard Time", "China Daylight Time", "China Time"},
+			"Europe_Central":    {"Central European Standard Time", "Central European Summer Time", "Central European Time"},
+			"Europe_Eastern":    {"Eastern European Standard Time", "Eastern European Summer Time", "Eastern European Time"},
+			"Europe_Western":    {"Western European Standard Time", "Western European Summer Time", "Western European Time"},
+			"Hawaii_Aleutian":   {"Hawaii-Aleutian Standard Time", "Hawaii-Aleutian Daylight Time", "Hawaii-Aleutian Time"},
+			"India":             {"India Standard Time", "", "India Standard Time"},
+			"Japan":             {"Japan Standard Time", "Japan Daylight Time", "Japan Time"},
+			"Moscow":            {"Moscow Standard Time", "Moscow Summer Time", "Moscow Time"},
+		},
+		ZoneNamesShort: map[string]string{
+			"Alaska":           "AKT",
+			"America_Central":  "CT",
+			"America_Eastern":  "ET",
+			"America_Mountain": "MT",
+			"America_Pacific":  "PT",
+			"Atlantic":         "AT",
+			"Hawaii_Aleutian":  "HST",
+		},
+	},
+	"en-001": {
+		Months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		MonthsAbbr: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		Days:       [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		DaysAbbr:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		DayPeriods: [2]string{"am", "pm"},
+		Eras:       [2]string{"BC", "AD"},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E dd/MM",
+			"MMM":    "LLL",
+			"MMMEd":  "E d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "dd/MM",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM/y",
+			"yMEd":   "E, dd/MM/y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E, d MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "dd/MM/y",
+		},
+		ZoneNames: map[string][3]string{
+			"Alaska":            {"Alaska Standard Time", "Alaska Daylight Time", "Alaska Time"},
+			"America_Central":   {"Central Standard Time", "Central Daylight Time", "Central Time"},
+			"America_Eastern":   {"Eastern Standard Time", "Eastern Daylight Time", "Eastern Time"},
+			"America_Mountain":  {"Mountain Standard Time", "Mountain Daylight Time", "Mountain Time"},
+			"America_Pacific":   {"Pacific Standard Time", "Pacific Daylight Time", "Pacific Time"},
+			"Atlantic":          {"Atlantic Standard Time", "Atlantic Daylight Time", "Atlantic Time"},
+			"Australia_Eastern": {"Australian Eastern Standard Time", "Australian Eastern Daylight Time", "Eastern Australia Time"},
+			"Brasilia":          {"Brasilia Standard Time", "Brasilia Summer Time", "Brasilia Time"},
+			"China":             {"China Standard Time", "China Daylight Time", "China Time"},
+			"Europe_Central":    {"Central European Standard Time", "Central European Summer Time", "Central European Time"},
+			"Europe_Eastern":    {"Eastern European Standard Time", "Eastern European Summer Time", "Eastern European Time"},
+			"Europe_Western":    {"Western European Standard Time", "Western European Summer Time", "Western European Time"},
+			"Hawaii_Aleutian":   {"Hawaii-Aleutian Standard Time", "Hawaii-Aleutian Daylight Time", "Hawaii-Aleutian Time"},
+			"India":             {"India Standard Time", "", "India Standard Time"},
+			"Japan":             {"Japan Standard Time", "Japan Daylight Time", "Japan Time"},
+			"Moscow":            {"Moscow Standard Time", "Moscow Summer Time", "Moscow Time"},
+		},
+	},
+	"de": {
+		Months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		MonthsAbbr: [12]string{
+			"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+		},
+		Days:       [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		DaysAbbr:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"v. Chr.", "n. Chr."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E, d.",
+			"H":      "HH 'Uhr'",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, d.M.",
+			"MMM":    "LLL",
+			"MMMEd":  "E, d. MMM",
+			"MMMMd":  "d. MMMM",
+			"MMMd":   "d. MMM",
+			"Md":     "d.M.",
+			"d":      "d",
+			"h":      "h 'Uhr' a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "M/y",
+			"yMEd":   "E, d.M.y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E, d. MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d. MMMM y",
+			"yMMMd":  "d. MMM y",
+			"yMd":    "d.M.y",
+		},
+		ZoneNames: map[string][3]string{
+			"America_Eastern": {"Nordamerikanische Ostküsten-Normalzeit", "Nordamerikanische Ostküsten-Sommerzeit", "Nordamerikanische Ostküstenzeit"},
+			"America_Pacific": {"Nordamerikanische Westküsten-Normalzeit", "Nordamerikanische Westküsten-Sommerzeit", "Nordamerikanische Westküstenzeit"},
+			"Europe_Central":  {"Mitteleuropäische Normalzeit", "Mitteleuropäische Sommerzeit", "Mitteleuropäische Zeit"},
+			"Europe_Eastern":  {"Osteuropäische Normalzeit", "Osteuropäische Sommerzeit", "Osteuropäische Zeit"},
+			"Europe_Western":  {"Westeuropäische Normalzeit", "Westeuropäische Sommerzeit", "Westeuropäische Zeit"},
+		},
+	},
+	"es": {
+		Months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		MonthsAbbr: [12]string{
+			"ene.", "feb.", "mar.", "abr.", "may.", "jun.",
+			"jul.", "ago.", "sept.", "oct.", "nov.", "dic.",
+		},
+		Days:       [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		DaysAbbr:   [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+		DayPeriods: [2]string{"a.\u00a0m.", "p.\u00a0m."},
+		Eras:       [2]string{"a. C.", "d. C."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy",
+		},
+		TimePatterns: [4]string{
+			"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E d",
+			"H":      "H",
+			"Hm":     "H:mm",
+			"Hms":    "H:mm:ss",
+			"M":      "L",
+			"MEd":    "E, d/M",
+			"MMM":    "LLL",
+			"MMMEd":  "E, d MMM",
+			"MMMMd":  "d 'de' MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "d/M",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "M/y",
+			"yMEd":   "EEE, d/M/y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "EEE, d MMM y",
+			"yMMMM":  "MMMM 'de' y",
+			"yMMMMd": "d 'de' MMMM 'de' y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "d/M/y",
+		},
+	},
+	"fr": {
+		Months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		MonthsAbbr: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		Days:       [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		DaysAbbr:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"av. J.-C.", "ap. J.-C."},
+		GMT:        "UTC",
+		DatePatterns: [4]string{
+			"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "E",
+			"Ed":     "E d",
+			"H":      "HH 'h'",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E dd/MM",
+			"MMM":    "LLL",
+			"MMMEd":  "E d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "dd/MM",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM/y",
+			"yMEd":   "E dd/MM/y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E d MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "dd/MM/y",
+		},
+		ZoneNames: map[string][3]string{
+			"America_Eastern": {"heure normale de l’Est nord-américain", "heure d’été de l’Est", "heure de l’Est nord-américain"},
+			"America_Pacific": {"heure normale du Pacifique nord-américain", "heure d’été du Pacifique", "heure du Pacifique nord-américain"},
+			"Europe_Central":  {"heure normale d’Europe centrale", "heure d’été d’Europe centrale", "heure d’Europe centrale"},
+			"Europe_Eastern":  {"heure normale d’Europe de l’Est", "heure d’été d’Europe de l’Est", "heure d’Europe de l’Est"},
+			"Europe_Western":  {"heure normale d’Europe de l’Ouest", "heure d’été d’Europe de l’Ouest", "heure d’Europe de l’Ouest"},
+		},
+	},
+	"it": {
+		Months: [12]string{
+			"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+			"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre",
+		},
+		MonthsAbbr: [12]string{
+			"gen", "feb", "mar", "apr", "mag", "giu",
+			"lug", "ago", "set", "ott", "nov", "dic",
+		},
+		Days:       [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		DaysAbbr:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"a.C.", "d.C."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/yy",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "EEE",
+			"Ed":     "E d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E d/M",
+			"MMM":    "LLL",
+			"MMMEd":  "E d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "d/M",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "M/y",
+			"yMEd":   "E d/M/y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E d MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "d/M/y",
+		},
+	},
+	"ja": {
+		Months: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		MonthsAbbr: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		Days:       [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		DaysAbbr:   [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		DayPeriods: [2]string{"午前", "午後"},
+		Eras:       [2]string{"紀元前", "西暦"},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd",
+		},
+		TimePatterns: [4]string{
+			"H時mm分ss秒 zzzz", "H:mm:ss z", "H:mm:ss", "H:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "d日(E)",
+			"H":      "H時",
+			"Hm":     "H:mm",
+			"Hms":    "H:mm:ss",
+			"M":      "M月",
+			"MEd":    "M/d(E)",
+			"MMM":    "M月",
+			"MMMEd":  "M月d日(E)",
+			"MMMMd":  "M月d日",
+			"MMMd":   "M月d日",
+			"Md":     "M/d",
+			"d":      "d日",
+			"h":      "aK時",
+			"hm":     "aK:mm",
+			"hms":    "aK:mm:ss",
+			"ms":     "mm:ss",
+			"y":      "y年",
+			"yM":     "y/M",
+			"yMEd":   "y/M/d(E)",
+			"yMMM":   "y年M月",
+			"yMMMEd": "y年M月d日(E)",
+			"yMMMM":  "y年M月",
+			"yMMMMd": "y年M月d日",
+			"yMMMd":  "y年M月d日",
+			"yMd":    "y/M/d",
+		},
+	},
+	"nl": {
+		Months: [12]string{
+			"januari", "februari", "maart", "april", "mei", "juni",
+			"juli", "augustus", "september", "oktober", "november", "december",
+		},
+		MonthsAbbr: [12]string{
+			"jan.", "feb.", "mrt.", "apr.", "mei", "jun.",
+			"jul.", "aug.", "sep.", "okt.", "nov.", "dec.",
+		},
+		Days:       [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		DaysAbbr:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		DayPeriods: [2]string{"a.m.", "p.m."},
+		Eras:       [2]string{"v.Chr.", "n.Chr."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E d-M",
+			"MMM":    "LLL",
+			"MMMEd":  "E d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "d-M",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "M-y",
+			"yMEd":   "E d-M-y",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E d MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "d-M-y",
+		},
+	},
+	"pl": {
+		Months: [12]string{
+			"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca",
+			"lipca", "sierpnia", "września", "października", "listopada", "grudnia",
+		},
+		MonthsAbbr: [12]string{
+			"sty", "lut", "mar", "kwi", "maj", "cze",
+			"lip", "sie", "wrz", "paź", "lis", "gru",
+		},
+		MonthsStandalone: [12]string{
+			"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec",
+			"lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień",
+		},
+		Days:       [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		DaysAbbr:   [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"p.n.e.", "n.e."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d MMMM y", "d MMMM y", "d MMM y", "d.MM.y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E, d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, d.MM",
+			"MMM":    "LLL",
+			"MMMEd":  "E, d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "d.MM",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM.y",
+			"yMEd":   "E, d.MM.y",
+			"yMMM":   "LLL y",
+			"yMMMEd": "E, d MMM y",
+			"yMMMM":  "LLLL y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "d.MM.y",
+		},
+	},
+	"pt": {
+		Months: [12]string{
+			"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+		},
+		MonthsAbbr: [12]string{
+			"jan.", "fev.", "mar.", "abr.", "mai.", "jun.",
+			"jul.", "ago.", "set.", "out.", "nov.", "dez.",
+		},
+		Days:       [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		DaysAbbr:   [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"a.C.", "d.C."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d 'de' MMM 'de' y", "dd/MM/y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E, d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, dd/MM",
+			"MMM":    "LLL",
+			"MMMEd":  "E, d 'de' MMM",
+			"MMMMd":  "d 'de' MMMM",
+			"MMMd":   "d 'de' MMM",
+			"Md":     "d/M",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM/y",
+			"yMEd":   "E, dd/MM/y",
+			"yMMM":   "MMM 'de' y",
+			"yMMMEd": "E, d 'de' MMM 'de' y",
+			"yMMMM":  "MMMM 'de' y",
+			"yMMMMd": "d 'de' MMMM 'de' y",
+			"yMMMd":  "d 'de' MMM 'de' y",
+			"yMd":    "dd/MM/y",
+		},
+	},
+	"pt-PT": {
+		Months: [12]string{
+			"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+		},
+		MonthsAbbr: [12]string{
+			"jan.", "fev.", "mar.", "abr.", "mai.", "jun.",
+			"jul.", "ago.", "set.", "out.", "nov.", "dez.",
+		},
+		Days:       [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		DaysAbbr:   [7]string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"},
+		DayPeriods: [2]string{"da manhã", "da tarde"},
+		Eras:       [2]string{"a.C.", "d.C."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "dd/MM/y", "dd/MM/yy",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E, d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, dd/MM",
+			"MMM":    "LLL",
+			"MMMEd":  "E, d/MM",
+			"MMMMd":  "d 'de' MMMM",
+			"MMMd":   "d/MM",
+			"Md":     "dd/MM",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM/y",
+			"yMEd":   "E, dd/MM/y",
+			"yMMM":   "MM/y",
+			"yMMMEd": "E, d/MM/y",
+			"yMMMM":  "MMMM 'de' y",
+			"yMMMMd": "d 'de' MMMM 'de' y",
+			"yMMMd":  "d/MM/y",
+			"yMd":    "dd/MM/y",
+		},
+	},
+	"ru": {
+		Months: [12]string{
+			"января", "февраля", "марта", "апреля", "мая", "июня",
+			"июля", "августа", "сентября", "октября", "ноября", "декабря",
+		},
+		MonthsAbbr: [12]string{
+			"янв.", "февр.", "мар.", "апр.", "мая", "июн.",
+			"июл.", "авг.", "сент.", "окт.", "нояб.", "дек.",
+		},
+		MonthsStandalone: [12]string{
+			"январь", "февраль", "март", "апрель", "май", "июнь",
+			"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+		},
+		Days:       [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+		DaysAbbr:   [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+		DayPeriods: [2]string{"AM", "PM"},
+		Eras:       [2]string{"до н. э.", "н. э."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE, d MMMM y 'г'.", "d MMMM y 'г'.", "d MMM y 'г'.", "dd.MM.y",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1}, {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "ccc, d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E, dd.MM",
+			"MMM":    "LLL",
+			"MMMEd":  "ccc, d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "dd.MM",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "MM.y",
+			"yMEd":   "ccc, dd.MM.y 'г'.",
+			"yMMM":   "LLL y 'г'.",
+			"yMMMEd": "E, d MMM y 'г'.",
+			"yMMMM":  "LLLL y 'г'.",
+			"yMMMMd": "d MMMM y 'г'.",
+			"yMMMd":  "d MMM y 'г'.",
+			"yMd":    "dd.MM.y",
+		},
+	},
+	"sv": {
+		Months: [12]string{
+			"januari", "februari", "mars", "april", "maj", "juni",
+			"juli", "augusti", "september", "oktober", "november", "december",
+		},
+		MonthsAbbr: [12]string{
+			"jan.", "feb.", "mars", "apr.", "maj", "juni",
+			"juli", "aug.", "sep.", "okt.", "nov.", "dec.",
+		},
+		Days:       [7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+		DaysAbbr:   [7]string{"sön", "mån", "tis", "ons", "tors", "fre", "lör"},
+		DayPeriods: [2]string{"fm", "em"},
+		Eras:       [2]string{"f.Kr.", "e.Kr."},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"EEEE d MMMM y", "d MMMM y", "d MMM y", "y-MM-dd",
+		},
+		TimePatterns: [4]string{
+			"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "E d",
+			"H":      "HH",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "L",
+			"MEd":    "E d/M",
+			"MMM":    "LLL",
+			"MMMEd":  "E d MMM",
+			"MMMMd":  "d MMMM",
+			"MMMd":   "d MMM",
+			"Md":     "d/M",
+			"d":      "d",
+			"h":      "h a",
+			"hm":     "h:mm a",
+			"hms":    "h:mm:ss a",
+			"ms":     "mm:ss",
+			"y":      "y",
+			"yM":     "y-MM",
+			"yMEd":   "E, y-MM-dd",
+			"yMMM":   "MMM y",
+			"yMMMEd": "E d MMM y",
+			"yMMMM":  "MMMM y",
+			"yMMMMd": "d MMMM y",
+			"yMMMd":  "d MMM y",
+			"yMd":    "y-MM-dd",
+		},
+	},
+	"zh": {
+		Months: [12]string{
+			"一月", "二月", "三月", "四月", "五月", "六月",
+			"七月", "八月", "九月", "十月", "十一月", "十二月",
+		},
+		MonthsAbbr: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		Days:       [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		DaysAbbr:   [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		DayPeriods: [2]string{"上午", "下午"},
+		Eras:       [2]string{"公元前", "公元"},
+		GMT:        "GMT",
+		DatePatterns: [4]string{
+			"y年M月d日EEEE", "y年M月d日", "y年M月d日", "y/M/d",
+		},
+		TimePatterns: [4]string{
+			"zzzz HH:mm:ss", "z HH:mm:ss", "HH:mm:ss", "HH:mm",
+		},
+		DateTimeFormat: "{1} {0}",
+		AvailableFormats: map[string]string{
+			"E":      "ccc",
+			"Ed":     "d日E",
+			"H":      "H时",
+			"Hm":     "HH:mm",
+			"Hms":    "HH:mm:ss",
+			"M":      "M月",
+			"MEd":    "M/dE",
+			"MMM":    "LLL",
+			"MMMEd":  "M月d日E",
+			"MMMMd":  "M月d日",
+			"MMMd":   "M月d日",
+			"Md":     "M/d",
+			"d":      "d日",
+			"h":      "ah时",
+			"hm":     "ah:mm",
+			"hms":    "ah:mm:ss",
+			"ms":     "mm:ss",
+			"y":      "y年",
+			"yM":     "y/M",
+			"yMEd":   "y/M/dE",
+			"yMMM":   "y年M月",
+			"yMMMEd": "y年M月d日E",
+			"yMMMM":  "y年M月",
+			"yMMMMd": "y年M月d日",
+			"yMMMd":  "y年M月d日",
+			"yMd":    "y/M/d",
+		},
+	},
+}
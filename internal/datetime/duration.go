@@ -0,0 +1,246 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
+)
+
+// DurationStyle selects how durations are rendered.
+type DurationStyle int
+
+const (
+	NumericDuration DurationStyle = iota // "1:02:03"
+	LongDuration                         // "1 hour, 2 minutes, 3 seconds"
+	ShortDuration                        // "1 hr, 2 min, 3 sec"
+)
+
+// durationUnits are the names of hours, minutes and seconds by plural
+// category, with "{0}" standing for the number.
+type durationUnits struct {
+	long    [3]map[plural.Form]string
+	short   [3]map[plural.Form]string
+	listSep string // between all but the last two units
+	lastSep string // between the last two units
+}
+
+func units(other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.Other: other}
+}
+
+func units2(one, other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.One: one, plural.Other: other}
+}
+
+func units4(one, few, many, other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.One: one, plural.Few: few, plural.Many: many, plural.Other: other}
+}
+
+// allDurationUnits is keyed by base language.
+var allDurationUnits = map[string]*durationUnits{
+	"de": {
+		long: [3]map[plural.Form]string{
+			units2("{0} Stunde", "{0} Stunden"),
+			units2("{0} Minute", "{0} Minuten"),
+			units2("{0} Sekunde", "{0} Sekunden"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} Std."), units("{0} Min."), units("{0} Sek.")},
+		listSep: ", ",
+		lastSep: " und ",
+	},
+	"en": {
+		long: [3]map[plural.Form]string{
+			units2("{0} hour", "{0} hours"),
+			units2("{0} minute", "{0} minutes"),
+			units2("{0} second", "{0} seconds"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} hr"), units("{0} min"), units("{0} sec")},
+		listSep: ", ",
+		lastSep: ", ",
+	},
+	"es": {
+		long: [3]map[plural.Form]string{
+			units2("{0} hora", "{0} horas"),
+			units2("{0} minuto", "{0} minutos"),
+			units2("{0} segundo", "{0} segundos"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} h"), units("{0} min"), units("{0} s")},
+		listSep: ", ",
+		lastSep: " y ",
+	},
+	"fr": {
+		long: [3]map[plural.Form]string{
+			units2("{0} heure", "{0} heures"),
+			units2("{0} minute", "{0} minutes"),
+			units2("{0} seconde", "{0} secondes"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} h"), units("{0} min"), units("{0} s")},
+		listSep: ", ",
+		lastSep: " et ",
+	},
+	"it": {
+		long: [3]map[plural.Form]string{
+			units2("{0} ora", "{0} ore"),
+			units2("{0} minuto", "{0} minuti"),
+			units2("{0} secondo", "{0} secondi"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} h"), units("{0} min"), units("{0} s")},
+		listSep: ", ",
+		lastSep: " e ",
+	},
+	"ja": {
+		long:    [3]map[plural.Form]string{units("{0} 時間"), units("{0} 分"), units("{0} 秒")},
+		short:   [3]map[plural.Form]string{units("{0} 時間"), units("{0} 分"), units("{0} 秒")},
+		listSep: " ",
+		lastSep: " ",
+	},
+	"nl": {
+		long: [3]map[plural.Form]string{
+			units2("{0} uur", "{0} uur"),
+			units2("{0} minuut", "{0} minuten"),
+			units2("{0} seconde", "{0} seconden"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} uur"), units("{0} min"), units("{0} sec")},
+		listSep: ", ",
+		lastSep: " en ",
+	},
+	"pl": {
+		long: [3]map[plural.Form]string{
+			units4("{0} godzina", "{0} godziny", "{0} godzin", "{0} godziny"),
+			units4("{0} minuta", "{0} minuty", "{0} minut", "{0} minuty"),
+			units4("{0} sekunda", "{0} sekundy", "{0} sekund", "{0} sekundy"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} godz."), units("{0} min"), units("{0} sek.")},
+		listSep: ", ",
+		lastSep: " i ",
+	},
+	"pt": {
+		long: [3]map[plural.Form]string{
+			units2("{0} hora", "{0} horas"),
+			units2("{0} minuto", "{0} minutos"),
+			units2("{0} segundo", "{0} segundos"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} h"), units("{0} min"), units("{0} s")},
+		listSep: ", ",
+		lastSep: " e ",
+	},
+	"ru": {
+		long: [3]map[plural.Form]string{
+			units4("{0} час", "{0} часа", "{0} часов", "{0} часа"),
+			units4("{0} минута", "{0} минуты", "{0} минут", "{0} минуты"),
+			units4("{0} секунда", "{0} секунды", "{0} секунд", "{0} секунды"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} ч"), units("{0} мин"), units("{0} с")},
+		listSep: " ",
+		lastSep: " ",
+	},
+	"sv": {
+		long: [3]map[plural.Form]string{
+			units2("{0} timme", "{0} timmar"),
+			units2("{0} minut", "{0} minuter"),
+			units2("{0} sekund", "{0} sekunder"),
+		},
+		short:   [3]map[plural.Form]string{units("{0} tim"), units("{0} min"), units("{0} s")},
+		listSep: ", ",
+		lastSep: " och ",
+	},
+	"zh": {
+		long:    [3]map[plural.Form]string{units("{0}小时"), units("{0}分钟"), units("{0}秒")},
+		short:   [3]map[plural.Form]string{units("{0}小时"), units("{0}分钟"), units("{0}秒")},
+		listSep: "",
+		lastSep: "",
+	},
+}
+
+// Duration renders durations in one language and style.
+type Duration struct {
+	lang  language.Tag
+	rules language.Tag // base language for plural rules
+	style DurationStyle
+	units *durationUnits
+}
+
+// NewDuration returns a duration formatter for lang. The long and short
+// styles need unit names, bundled for de, en, es, fr, it, ja, nl, pl, pt,
+// ru, sv and zh; other languages are reported as an error rather than
+// shown in English.
+func NewDuration(lang language.Tag, style DurationStyle) (*Duration, error) {
+	f := &Duration{lang: lang, style: style}
+	if style == NumericDuration {
+		return f, nil
+	}
+	base, _ := lang.Base()
+	u, ok := allDurationUnits[base.String()]
+	if !ok {
+		return nil, fmt.Errorf("no duration units for language: %q", lang)
+	}
+	f.rules, _ = language.Compose(base)
+	f.units = u
+	return f, nil
+}
+
+// Format renders a number of seconds, rounded to whole seconds.
+func (f *Duration) Format(seconds decimal.Decimal) string {
+	seconds = seconds.Round(0)
+	total, _ := strconv.ParseInt(seconds.Int, 10, 64)
+	parts := [3]int64{total / 3600, total % 3600 / 60, total % 60}
+
+	if f.style == NumericDuration {
+		sym := number.SymbolsFor(f.lang)
+		var b strings.Builder
+		if seconds.Neg {
+			b.WriteString(sym.Minus)
+		}
+		if parts[0] > 0 {
+			b.WriteString(strconv.FormatInt(parts[0], 10))
+			b.WriteByte(':')
+			writeNumber(&b, int(parts[1]), 2)
+		} else {
+			b.WriteString(strconv.FormatInt(parts[1], 10))
+		}
+		b.WriteByte(':')
+		writeNumber(&b, int(parts[2]), 2)
+		return sym.Localize(b.String())
+	}
+
+	u := f.units
+	names := u.long
+	if f.style == ShortDuration {
+		names = u.short
+	}
+
+	integer := number.Integer(f.lang)
+	var items []string
+	for i, n := range parts {
+		if n == 0 && !(i == 2 && total == 0) {
+			continue
+		}
+		d, _ := decimal.New(n)
+		d.Neg = seconds.Neg && len(items) == 0
+		form := d.PluralForm(plural.Cardinal, f.rules)
+		pattern, ok := names[i][form]
+		if !ok {
+			pattern = names[i][plural.Other]
+		}
+		items = append(items, strings.Replace(pattern, "{0}", integer.Format(d), 1))
+	}
+
+	var b strings.Builder
+	for i, item := range items {
+		switch {
+		case i == 0:
+		case i == len(items)-1:
+			b.WriteString(u.lastSep)
+		default:
+			b.WriteString(u.listSep)
+		}
+		b.WriteString(item)
+	}
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package datetime
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestFormatDuration(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		seconds  string
+		style    DurationStyle
+		expected string
+	}{
+		{"en", "3723", NumericDuration, "1:02:03"},
+		{"en", "123", NumericDuration, "2:03"},
+		{"en", "59.5", NumericDuration, "1:00"},
+		{"en", "0", NumericDuration, "0:00"},
+		{"en", "-3600", NumericDuration, "-1:00:00"},
+		{"en", "360000", NumericDuration, "100:00:00"},
+		{"ar", "3723", NumericDuration, "١:٠٢:٠٣"},
+		{"en", "3723", LongDuration, "1 hour, 2 minutes, 3 seconds"},
+		{"en", "3600", LongDuration, "1 hour"},
+		{"en", "61", LongDuration, "1 minute, 1 second"},
+		{"en", "0", LongDuration, "0 seconds"},
+		{"en", "-7200", LongDuration, "-2 hours"},
+		{"en", "3723", ShortDuration, "1 hr, 2 min, 3 sec"},
+		{"en", "3600000", LongDuration, "1,000 hours"},
+		{"de", "3723", LongDuration, "1 Stunde, 2 Minuten und 3 Sekunden"},
+		{"fr", "7320", LongDuration, "2 heures et 2 minutes"},
+		{"ru", "18125", LongDuration, "5 часов 2 минуты 5 секунд"},
+		{"pl", "1320", LongDuration, "22 minuty"},
+		{"ja", "3723", ShortDuration, "1 時間 2 分 3 秒"},
+		{"xx", "3723", NumericDuration, "1:02:03"},
+		{"und", "3661", LongDuration, "1 hour, 1 minute, 1 second"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := decimal.Parse(tc.seconds)
+			require.NoError(err)
+			f, err := NewDuration(language.Make(tc.lang), tc.style)
+			require.NoError(err)
+			require.Equal(tc.expected, f.Format(d))
+		})
+	}
+}
+
+func TestNewDurationUnsupported(t *testing.T) {
+	for _, lang := range []string{"ko", "tr", "hi"} {
+		for _, style := range []DurationStyle{LongDuration, ShortDuration} {
+			_, err := NewDuration(language.Make(lang), style)
+			require.Error(t, err, lang)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Package datetime formats dates and times using CLDR patterns and
+// localized names.
+package datetime
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Width selects one of the standard date or time formats of a locale.
+type Width int
+
+const (
+	Full Width = iota
+	Long
+	Medium
+	Short
+)
+
+// Locale holds the names and standard patterns of one language.
+type Locale struct {
+	Months           [12]string // wide names used inside dates
+	MonthsAbbr       [12]string
+	MonthsStandalone [12]string // wide names used on their own, if different
+	Days             [7]string  // wide names, starting with Sunday
+	DaysAbbr         [7]string
+	DayPeriods       [2]string // AM and PM
+	Eras             [2]string // BC and AD
+	GMT              string    // prefix of localized offsets such as "GMT+1"
+	DatePatterns     [4]string // indexed by Width
+	TimePatterns     [4]string
+	DateTimeFormat   string               // joins a date "{1}" and a time "{0}"
+	AvailableFormats map[string]string    // patterns keyed by skeleton
+	ZoneNames        map[string][3]string // standard, daylight and generic names keyed by metazone
+	ZoneNamesShort   map[string]string    // short generic names, such as "PT", keyed by metazone
+}
+
+// LocaleFor returns the best available locale data for lang. Data is only
+// bundled for de, en, es, fr, it, ja, nl, pl, pt, ru, sv and zh, and their
+// regional variants; other languages are reported as an error rather than
+// shown with English names.
+func LocaleFor(lang language.Tag) (*Locale, error) {
+	for tag := lang; tag != language.Und; tag = tag.Parent() {
+		if loc, ok := locales[tag.String()]; ok {
+			return loc, nil
+		}
+	}
+	base, _ := lang.Base()
+	if loc, ok := locales[base.String()]; ok {
+		return loc, nil
+	}
+	return nil, fmt.Errorf("no date and time data for language: %q", lang)
+}
+
+// DatePattern returns the standard date pattern for width.
+func (l *Locale) DatePattern(width Width) *Pattern {
+	return MustCompile(l.DatePatterns[width])
+}
+
+// TimePattern returns the standard time pattern for width.
+func (l *Locale) TimePattern(width Width) *Pattern {
+	return MustCompile(l.TimePatterns[width])
+}
+
+func (l *Locale) standaloneMonth(month int) string {
+	if name := l.MonthsStandalone[month]; name != "" {
+		return name
+	}
+	return l.Months[month]
+}
+
+// gmtOffset renders offset as "GMT-08:00", or as "GMT-8" when short.
+// Zero offsets are rendered as just "GMT".
+func (l *Locale) gmtOffset(offset int, short bool) string {
+	if offset == 0 {
+		return l.GMT
+	}
+	var b strings.Builder
+	b.WriteString(l.GMT)
+	if offset < 0 {
+		b.WriteByte('-')
+		offset = -offset
+	} else {
+		b.WriteByte('+')
+	}
+	hours, minutes := offset/3600, offset%3600/60
+	if short {
+		writeNumber(&b, hours, 1)
+		if minutes != 0 {
+			b.WriteByte(':')
+			writeNumber(&b, minutes, 2)
+		}
+	} else {
+		writeNumber(&b, hours, 2)
+		b.WriteByte(':')
+		writeNumber(&b, minutes, 2)
+	}
+	return b.String()
+}
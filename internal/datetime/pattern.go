@@ -0,0 +1,293 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Pattern is a compiled CLDR date format pattern such as "MMM d, y".
+//
+// See https://unicode.org/reports/tr35/tr35-dates.html#Date_Field_Symbol_Table
+type Pattern struct {
+	fields []field
+}
+
+type field struct {
+	symbol  byte // pattern letter, or 0 for literal text
+	count   int
+	literal string
+}
+
+const supportedSymbols = "GyYuMLdDEecaHhKkmsSzZOXxvVQq"
+
+// Compile parses a date format pattern.
+func Compile(pattern string) (*Pattern, error) {
+	p := &Pattern{}
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			p.fields = append(p.fields, field{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		ch := pattern[i]
+		switch {
+		case ch == '\'':
+			if strings.HasPrefix(pattern[i:], "''") {
+				literal.WriteByte('\'')
+				i += 2
+				continue
+			}
+			end := i + 1
+			for {
+				j := strings.IndexByte(pattern[end:], '\'')
+				if j < 0 {
+					return nil, fmt.Errorf("unterminated quote in date pattern: %q", pattern)
+				}
+				literal.WriteString(pattern[end : end+j])
+				end += j + 1
+				if !strings.HasPrefix(pattern[end:], "'") {
+					break
+				}
+				literal.WriteByte('\'')
+				end++
+			}
+			i = end
+		case ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+			if strings.IndexByte(supportedSymbols, ch) < 0 {
+				return nil, fmt.Errorf("unsupported date pattern field: %q", string(ch))
+			}
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == ch {
+				count++
+			}
+			flush()
+			p.fields = append(p.fields, field{symbol: ch, count: count})
+			i += count
+		default:
+			_, n := utf8.DecodeRuneInString(pattern[i:])
+			literal.WriteString(pattern[i : i+n])
+			i += n
+		}
+	}
+	flush()
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the pattern is invalid.
+func MustCompile(pattern string) *Pattern {
+	p, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Format renders t using the names of loc.
+func (p *Pattern) Format(loc *Locale, t time.Time) string {
+	var b strings.Builder
+	for _, f := range p.fields {
+		if f.symbol == 0 {
+			b.WriteString(f.literal)
+		} else {
+			f.format(&b, loc, t)
+		}
+	}
+	return b.String()
+}
+
+func (f field) format(b *strings.Builder, loc *Locale, t time.Time) {
+	switch f.symbol {
+	case 'G':
+		era := 1
+		if t.Year() <= 0 {
+			era = 0
+		}
+		b.WriteString(loc.Eras[era])
+	case 'y', 'Y', 'u':
+		year := t.Year()
+		if f.symbol != 'u' && year <= 0 {
+			year = 1 - year
+		}
+		if f.count == 2 {
+			writeNumber(b, year%100, 2)
+		} else {
+			writeNumber(b, year, f.count)
+		}
+	case 'M', 'L':
+		month := int(t.Month()) - 1
+		switch {
+		case f.count <= 2:
+			writeNumber(b, month+1, f.count)
+		case f.count == 3:
+			b.WriteString(loc.MonthsAbbr[month])
+		case f.count == 4 && f.symbol == 'L':
+			b.WriteString(loc.standaloneMonth(month))
+		case f.count == 4:
+			b.WriteString(loc.Months[month])
+		default:
+			b.WriteString(narrow(loc.standaloneMonth(month)))
+		}
+	case 'd':
+		writeNumber(b, t.Day(), f.count)
+	case 'D':
+		writeNumber(b, t.YearDay(), f.count)
+	case 'E', 'e', 'c':
+		day := int(t.Weekday())
+		switch {
+		case f.symbol != 'E' && f.count <= 2:
+			writeNumber(b, day+1, f.count)
+		case f.count == 4:
+			b.WriteString(loc.Days[day])
+		case f.count == 5:
+			b.WriteString(narrow(loc.Days[day]))
+		default:
+			b.WriteString(loc.DaysAbbr[day])
+		}
+	case 'Q', 'q':
+		quarter := (int(t.Month())-1)/3 + 1
+		if f.count <= 2 {
+			writeNumber(b, quarter, f.count)
+		} else {
+			b.WriteString("Q" + strconv.Itoa(quarter))
+		}
+	case 'a':
+		b.WriteString(loc.DayPeriods[t.Hour()/12])
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		writeNumber(b, hour, f.count)
+	case 'H':
+		writeNumber(b, t.Hour(), f.count)
+	case 'K':
+		writeNumber(b, t.Hour()%12, f.count)
+	case 'k':
+		hour := t.Hour()
+		if hour == 0 {
+			hour = 24
+		}
+		writeNumber(b, hour, f.count)
+	case 'm':
+		writeNumber(b, t.Minute(), f.count)
+	case 's':
+		writeNumber(b, t.Second(), f.count)
+	case 'S':
+		digits := fmt.Sprintf("%09d", t.Nanosecond())
+		for len(digits) < f.count {
+			digits += "0"
+		}
+		b.WriteString(digits[:f.count])
+	default:
+		f.formatZone(b, loc, t)
+	}
+}
+
+func (f field) formatZone(b *strings.Builder, loc *Locale, t time.Time) {
+	name, offset := t.Zone()
+	switch f.symbol {
+	case 'z', 'v':
+		zone, ok := "", false
+		if f.count >= 4 {
+			zone, ok = loc.zoneName(t, f.symbol == 'v')
+		} else if f.symbol == 'v' {
+			zone, ok = loc.shortGenericZoneName(t)
+		}
+		switch {
+		case ok:
+			b.WriteString(zone)
+		case f.count < 4 && isZoneAbbreviation(name):
+			b.WriteString(name)
+		default:
+			b.WriteString(loc.gmtOffset(offset, f.count < 4))
+		}
+	case 'Z':
+		switch f.count {
+		case 4:
+			b.WriteString(loc.gmtOffset(offset, false))
+		case 5:
+			if offset == 0 {
+				b.WriteByte('Z')
+			} else {
+				writeISOOffset(b, offset, true, false)
+			}
+		default:
+			writeISOOffset(b, offset, false, false)
+		}
+	case 'O':
+		b.WriteString(loc.gmtOffset(offset, f.count < 4))
+	case 'X', 'x':
+		if f.symbol == 'X' && offset == 0 {
+			b.WriteByte('Z')
+			return
+		}
+		switch f.count {
+		case 1:
+			writeISOOffset(b, offset, false, offset%3600 == 0)
+		case 2, 4:
+			writeISOOffset(b, offset, false, false)
+		default:
+			writeISOOffset(b, offset, true, false)
+		}
+	case 'V':
+		city, ok := exemplarCity(t)
+		switch {
+		case f.count == 2:
+			b.WriteString(t.Location().String())
+		case f.count == 3 && ok:
+			b.WriteString(city)
+		default:
+			b.WriteString(loc.gmtOffset(offset, false))
+		}
+	}
+}
+
+// isZoneAbbreviation reports whether name is an alphabetic abbreviation
+// such as "EST" rather than a numeric one such as "+03".
+func isZoneAbbreviation(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] < 'A' || name[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func writeISOOffset(b *strings.Builder, offset int, colon, hoursOnly bool) {
+	if offset < 0 {
+		b.WriteByte('-')
+		offset = -offset
+	} else {
+		b.WriteByte('+')
+	}
+	writeNumber(b, offset/3600, 2)
+	if hoursOnly {
+		return
+	}
+	if colon {
+		b.WriteByte(':')
+	}
+	writeNumber(b, offset%3600/60, 2)
+}
+
+func writeNumber(b *strings.Builder, n, width int) {
+	s := strconv.Itoa(n)
+	if len(s) < width {
+		b.WriteString(strings.Repeat("0", width-len(s)))
+	}
+	b.WriteString(s)
+}
+
+func narrow(name string) string {
+	r, _ := utf8.DecodeRuneInString(name)
+	return strings.ToUpper(string(r))
+}
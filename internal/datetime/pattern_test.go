@@ -0,0 +1,111 @@
+package datetime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestFormat(t *testing.T) {
+	pst := time.FixedZone("PST", -8*3600)
+	ist := time.FixedZone("", 5*3600+30*60)
+	afternoon := time.Date(2020, time.March, 7, 15, 4, 5, 678000000, pst)
+	morning := time.Date(1999, time.December, 31, 0, 9, 0, 0, time.UTC)
+	india := time.Date(2021, time.January, 2, 9, 0, 0, 0, ist)
+
+	for idx, tc := range []struct {
+		lang     string
+		pattern  string
+		t        time.Time
+		expected string
+	}{
+		{"en", "EEEE, MMMM d, y", afternoon, "Saturday, March 7, 2020"},
+		{"en", "EEE MMM dd yy", afternoon, "Sat Mar 07 20"},
+		{"en", "M/d/yy h:mm a", morning, "12/31/99 12:09 AM"},
+		{"en", "HH:mm:ss.SSS", afternoon, "15:04:05.678"},
+		{"en", "K k H h", morning, "0 24 0 12"},
+		{"en", "D QQQ G EEEEE MMMMM", afternoon, "67 Q1 AD S M"},
+		{"en", "h 'o''clock' a, ''yy", afternoon, "3 o'clock PM, '20"},
+		{"en", "z zzzz Z ZZZZ ZZZZZ", afternoon, "PST GMT-08:00 -0800 GMT-08:00 -08:00"},
+		{"en", "z zzzz O OOOO", india, "GMT+5:30 GMT+05:30 GMT+5:30 GMT+05:30"},
+		{"en", "X XX XXX x", morning, "Z Z Z +00"},
+		{"en", "X XXX xxx", afternoon, "-08 -08:00 -08:00"},
+		{"en", "zzzz", morning, "GMT"},
+		{"ru", "d MMMM, LLLL", afternoon, "7 марта, март"},
+		{"pl", "d MMMM, LLLL", afternoon, "7 marca, marzec"},
+		{"fr", "OOOO", afternoon, "UTC-08:00"},
+		{"de", "G y", time.Date(-43, time.March, 15, 0, 0, 0, 0, time.UTC), "v. Chr. 44"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			p, err := Compile(tc.pattern)
+			require.NoError(err)
+			loc, err := LocaleFor(language.MustParse(tc.lang))
+			require.NoError(err)
+			require.Equal(tc.expected, p.Format(loc, tc.t))
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, pattern := range []string{
+		"y 'unterminated",
+		"y-MM-dd W",
+	} {
+		_, err := Compile(pattern)
+		require.Error(t, err, pattern)
+	}
+}
+
+func TestStandardPatterns(t *testing.T) {
+	at := time.Date(2020, time.March, 7, 15, 4, 5, 0, time.UTC)
+
+	for idx, tc := range []struct {
+		lang  string
+		width Width
+		date  string
+		time  string
+	}{
+		{"en", Full, "Saturday, March 7, 2020", "3:04:05 PM GMT"},
+		{"en", Long, "March 7, 2020", "3:04:05 PM UTC"},
+		{"en", Medium, "Mar 7, 2020", "3:04:05 PM"},
+		{"en", Short, "3/7/20", "3:04 PM"},
+		{"en-GB", Short, "07/03/2020", "15:04"},
+		{"en-AU", Long, "7 March 2020", "15:04:05 UTC"},
+		{"de", Full, "Samstag, 7. März 2020", "15:04:05 GMT"},
+		{"de-AT", Medium, "07.03.2020", "15:04:05"},
+		{"es", Long, "7 de marzo de 2020", "15:04:05 UTC"},
+		{"es-MX", Full, "sábado, 7 de marzo de 2020", "15:04:05 (GMT)"},
+		{"fr", Medium, "7 mars 2020", "15:04:05"},
+		{"it", Short, "07/03/20", "15:04"},
+		{"ja", Full, "2020年3月7日土曜日", "15時04分05秒 GMT"},
+		{"nl", Long, "7 maart 2020", "15:04:05 UTC"},
+		{"pt", Medium, "7 de mar. de 2020", "15:04:05"},
+		{"pt-PT", Medium, "07/03/2020", "15:04:05"},
+		{"ru", Long, "7 марта 2020 г.", "15:04:05 UTC"},
+		{"sv", Short, "2020-03-07", "15:04"},
+		{"zh", Full, "2020年3月7日星期六", "GMT 15:04:05"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			loc, err := LocaleFor(language.Make(tc.lang))
+			require.NoError(err)
+			require.Equal(tc.date, loc.DatePattern(tc.width).Format(loc, at))
+			require.Equal(tc.time, loc.TimePattern(tc.width).Format(loc, at))
+		})
+	}
+}
+
+func TestLocaleForUnsupported(t *testing.T) {
+	for _, lang := range []string{"ko", "tr", "hi"} {
+		_, err := LocaleFor(language.MustParse(lang))
+		require.Error(t, err, lang)
+	}
+}
@@ -0,0 +1,306 @@
+package datetime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// skeletonField is one field requested by a skeleton. Symbol is the
+// canonical letter used by the keys of Locale.AvailableFormats, and
+// original is the letter as written.
+type skeletonField struct {
+	symbol   byte
+	original byte
+	count    int
+}
+
+const (
+	skeletonDateSymbols = "GyQMEd"
+	skeletonTimeSymbols = "hHms"
+	skeletonZoneSymbols = "zZOvVXx"
+)
+
+// SkeletonPattern returns the pattern of l that best matches an ICU date
+// skeleton such as "yMMMd". Skeletons list the wanted fields without
+// order or punctuation, which the locale supplies.
+//
+// See https://unicode.org/reports/tr35/tr35-dates.html#availableFormats_appendItems
+func (l *Locale) SkeletonPattern(skeleton string) (*Pattern, error) {
+	var date, clock []skeletonField
+	var zone, fraction *skeletonField
+	for i := 0; i < len(skeleton); {
+		ch := skeleton[i]
+		count := 1
+		for i+count < len(skeleton) && skeleton[i+count] == ch {
+			count++
+		}
+		i += count
+
+		f := skeletonField{symbol: ch, original: ch, count: count}
+		switch ch {
+		case 'y', 'Y', 'u':
+			f.symbol = 'y'
+		case 'q':
+			f.symbol = 'Q'
+		case 'L':
+			f.symbol = 'M'
+		case 'c', 'e':
+			f.symbol = 'E'
+		case 'K':
+			f.symbol = 'h'
+		case 'k':
+			f.symbol = 'H'
+		case 'j':
+			f.symbol = l.preferredHour()
+			f.original = f.symbol
+		case 'a':
+			// Day periods come with the locale's 12-hour patterns.
+			continue
+		case 'S':
+			fraction = &f
+			continue
+		}
+		switch {
+		case strings.IndexByte(skeletonDateSymbols, f.symbol) >= 0:
+			date = append(date, f)
+		case strings.IndexByte(skeletonTimeSymbols, f.symbol) >= 0:
+			clock = append(clock, f)
+		case strings.IndexByte(skeletonZoneSymbols, ch) >= 0:
+			zone = &f
+		default:
+			return nil, fmt.Errorf("unsupported date skeleton field: %q", string(ch))
+		}
+	}
+	if len(date) == 0 && len(clock) == 0 {
+		return nil, fmt.Errorf("unsupported date skeleton: %q", skeleton)
+	}
+
+	var datePart, timePart []field
+	if len(date) > 0 {
+		var missing []skeletonField
+		for {
+			datePart = l.matchSkeleton(date)
+			if datePart != nil || len(date) == 1 {
+				break
+			}
+			// Eras and quarters are appended when no pattern includes them.
+			i := indexSymbol(date, 'G')
+			if i < 0 {
+				i = indexSymbol(date, 'Q')
+			}
+			if i < 0 {
+				break
+			}
+			missing = append(missing, date[i])
+			date = append(date[:i:i], date[i+1:]...)
+		}
+		if datePart == nil {
+			return nil, fmt.Errorf("unsupported date skeleton: %q", skeleton)
+		}
+		for _, f := range missing {
+			datePart = append(datePart, field{literal: " "}, field{symbol: f.original, count: f.count})
+		}
+	}
+	if len(clock) > 0 {
+		if timePart = l.matchSkeleton(clock); timePart == nil {
+			return nil, fmt.Errorf("unsupported date skeleton: %q", skeleton)
+		}
+	}
+	if fraction != nil {
+		timePart = insertFraction(timePart, *fraction)
+	}
+	if zone != nil {
+		timePart = append(timePart, field{literal: " "}, field{symbol: zone.original, count: zone.count})
+	}
+
+	switch {
+	case datePart == nil:
+		return &Pattern{fields: timePart}, nil
+	case timePart == nil:
+		return &Pattern{fields: datePart}, nil
+	}
+	return l.joinDateTime(datePart, timePart)
+}
+
+// preferredHour returns 'h' for locales with 12-hour clocks and 'H' for
+// the rest.
+func (l *Locale) preferredHour() byte {
+	if strings.IndexByte(l.TimePatterns[Short], 'h') >= 0 {
+		return 'h'
+	}
+	return 'H'
+}
+
+// matchSkeleton returns the fields of the available format with the same
+// symbols as requested and the closest field lengths, adjusted to the
+// requested lengths. It returns nil when no format has those symbols.
+func (l *Locale) matchSkeleton(requested []skeletonField) []field {
+	keys := make([]string, 0, len(l.AvailableFormats))
+	for key := range l.AvailableFormats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	best, bestDistance := "", -1
+	for _, key := range keys {
+		fields := parseSkeletonKey(key)
+		if len(fields) != len(requested) {
+			continue
+		}
+		distance := 0
+		for _, r := range requested {
+			i := indexSymbol(fields, r.symbol)
+			if i < 0 {
+				distance = -1
+				break
+			}
+			distance += fieldDistance(r, fields[i].count)
+		}
+		if distance >= 0 && (bestDistance < 0 || distance < bestDistance) {
+			best, bestDistance = key, distance
+		}
+	}
+	if bestDistance < 0 {
+		return nil
+	}
+
+	p := MustCompile(l.AvailableFormats[best])
+	fields := make([]field, len(p.fields))
+	for i, f := range p.fields {
+		fields[i] = adjustField(f, requested)
+	}
+	return fields
+}
+
+// parseSkeletonKey splits an available format key such as "yMMMd".
+func parseSkeletonKey(key string) []skeletonField {
+	var fields []skeletonField
+	for i := 0; i < len(key); {
+		count := 1
+		for i+count < len(key) && key[i+count] == key[i] {
+			count++
+		}
+		fields = append(fields, skeletonField{symbol: key[i], original: key[i], count: count})
+		i += count
+	}
+	return fields
+}
+
+// fieldDistance compares field lengths, strongly preferring numeric months
+// for numeric requests and names for the rest.
+func fieldDistance(r skeletonField, count int) int {
+	distance := r.count - count
+	if distance < 0 {
+		distance = -distance
+	}
+	if r.symbol == 'M' && (r.count < 3) != (count < 3) {
+		distance += 0x100
+	}
+	return distance
+}
+
+func indexSymbol(fields []skeletonField, symbol byte) int {
+	for i, f := range fields {
+		if f.symbol == symbol {
+			return i
+		}
+	}
+	return -1
+}
+
+// adjustField applies the requested length of a pattern field, keeping the
+// locale's choice between numbers and names and its zero padding.
+func adjustField(f field, requested []skeletonField) field {
+	symbol := f.symbol
+	switch symbol {
+	case 0:
+		return f
+	case 'Y', 'u':
+		symbol = 'y'
+	case 'L':
+		symbol = 'M'
+	case 'c', 'e':
+		symbol = 'E'
+	case 'K':
+		symbol = 'h'
+	case 'k':
+		symbol = 'H'
+	}
+	i := indexSymbol(requested, symbol)
+	if i < 0 {
+		return f
+	}
+	r := requested[i]
+	switch symbol {
+	case 'M':
+		if r.count >= 3 && f.count >= 3 || r.count < 3 && r.count > f.count {
+			f.count = r.count
+		}
+	case 'E':
+		if r.count >= 4 {
+			f.count = r.count
+		}
+	case 'y', 'G', 'Q':
+		f.count = r.count
+		if symbol == 'y' {
+			f.symbol = r.original
+		}
+	case 'd':
+		if r.count > f.count {
+			f.count = r.count
+		}
+	case 'h', 'H':
+		if r.original == 'K' || r.original == 'k' {
+			f.symbol = r.original
+		}
+		if r.count > f.count {
+			f.count = r.count
+		}
+	}
+	return f
+}
+
+// insertFraction places fractional seconds after the seconds field.
+func insertFraction(fields []field, fraction skeletonField) []field {
+	for i, f := range fields {
+		if f.symbol == 's' {
+			rest := append([]field{{literal: "."}, {symbol: 'S', count: fraction.count}}, fields[i+1:]...)
+			return append(fields[:i+1:i+1], rest...)
+		}
+	}
+	return append(fields, field{literal: " "}, field{symbol: 'S', count: fraction.count})
+}
+
+// joinDateTime combines date and time fields using the DateTimeFormat of
+// l, where "{1}" stands for the date and "{0}" for the time.
+func (l *Locale) joinDateTime(date, clock []field) (*Pattern, error) {
+	p := &Pattern{}
+	glue := l.DateTimeFormat
+	for glue != "" {
+		i := strings.IndexByte(glue, '{')
+		if i < 0 || i+2 >= len(glue) || glue[i+2] != '}' {
+			i = len(glue)
+		}
+		if i > 0 {
+			literal, err := Compile(glue[:i])
+			if err != nil {
+				return nil, err
+			}
+			p.fields = append(p.fields, literal.fields...)
+		}
+		if i == len(glue) {
+			break
+		}
+		switch glue[i+1] {
+		case '0':
+			p.fields = append(p.fields, clock...)
+		case '1':
+			p.fields = append(p.fields, date...)
+		default:
+			return nil, fmt.Errorf("invalid date time format: %q", l.DateTimeFormat)
+		}
+		glue = glue[i+3:]
+	}
+	return p, nil
+}
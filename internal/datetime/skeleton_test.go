@@ -0,0 +1,79 @@
+package datetime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestSkeletonPattern(t *testing.T) {
+	at := time.Date(2020, time.March, 7, 15, 4, 5, 678000000, time.UTC)
+
+	for idx, tc := range []struct {
+		lang     string
+		skeleton string
+		expected string
+	}{
+		{"en", "yMMMd", "Mar 7, 2020"},
+		{"en", "yMMMMd", "March 7, 2020"},
+		{"en", "yMMMMEEEEd", "Saturday, March 7, 2020"},
+		{"en", "yMd", "3/7/2020"},
+		{"en", "yyMMdd", "03/07/20"},
+		{"en", "MMMMd", "March 7"},
+		{"en", "MMMM", "March"},
+		{"en", "EEEE", "Saturday"},
+		{"en", "yMMMdGGGG", "Mar 7, 2020 AD"},
+		{"en", "jmm", "3:04 PM"},
+		{"en", "Hmm", "15:04"},
+		{"en", "hhmmss", "03:04:05 PM"},
+		{"en", "Kmm", "3:04 PM"},
+		{"en", "Hmsz", "15:04:05 UTC"},
+		{"en", "HmsSSS", "15:04:05.678"},
+		{"en", "yMMMdjmm", "Mar 7, 2020, 3:04 PM"},
+		{"en-GB", "yMd", "07/03/2020"},
+		{"en-GB", "jmm", "15:04"},
+		{"de", "yMMMd", "7. März 2020"},
+		{"de", "yMMMMEEEEd", "Samstag, 7. März 2020"},
+		{"de", "Md", "7.3."},
+		{"de", "j", "15 Uhr"},
+		{"de", "yMMMdHm", "7. März 2020, 15:04"},
+		{"es", "yMMMMd", "7 de marzo de 2020"},
+		{"fr", "yMMMMEEEEd", "samedi 7 mars 2020"},
+		{"fr", "yMdHm", "07/03/2020 15:04"},
+		{"ja", "yMMMd", "2020年3月7日"},
+		{"ja", "hm", "午後3:04"},
+		{"pt", "yMMMd", "7 de mar. de 2020"},
+		{"ru", "yMMMMd", "7 марта 2020 г."},
+		{"ru", "LLLL", "март"},
+		{"sv", "yMd", "2020-03-07"},
+		{"zh", "yMMMd", "2020年3月7日"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			loc, err := LocaleFor(language.Make(tc.lang))
+			require.NoError(err)
+			p, err := loc.SkeletonPattern(tc.skeleton)
+			require.NoError(err)
+			require.Equal(tc.expected, p.Format(loc, at))
+		})
+	}
+}
+
+func TestSkeletonPatternErrors(t *testing.T) {
+	loc, _ := LocaleFor(language.English)
+	for _, skeleton := range []string{
+		"",
+		"a",
+		"yMMMW",
+		"G",
+		"Hs",
+	} {
+		_, err := loc.SkeletonPattern(skeleton)
+		require.Error(t, err, skeleton)
+	}
+}
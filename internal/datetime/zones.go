@@ -0,0 +1,106 @@
+package datetime
+
+import (
+	"strings"
+	"time"
+)
+
+// metaZone groups the time zones that share names, such as the zones of
+// "America_Pacific".
+type metaZone struct {
+	name     string
+	standard int // offset in seconds east of UTC outside daylight time
+}
+
+// metaZones maps IANA time zone IDs to their current metazone. Zones that
+// are not listed are shown with GMT offsets.
+var metaZones = map[string]metaZone{
+	"America/Anchorage":            {"Alaska", -9 * 3600},
+	"America/Chicago":              {"America_Central", -6 * 3600},
+	"America/Mexico_City":          {"America_Central", -6 * 3600},
+	"America/Winnipeg":             {"America_Central", -6 * 3600},
+	"America/Detroit":              {"America_Eastern", -5 * 3600},
+	"America/Indiana/Indianapolis": {"America_Eastern", -5 * 3600},
+	"America/New_York":             {"America_Eastern", -5 * 3600},
+	"America/Toronto":              {"America_Eastern", -5 * 3600},
+	"America/Denver":               {"America_Mountain", -7 * 3600},
+	"America/Edmonton":             {"America_Mountain", -7 * 3600},
+	"America/Phoenix":              {"America_Mountain", -7 * 3600},
+	"America/Los_Angeles":          {"America_Pacific", -8 * 3600},
+	"America/Tijuana":              {"America_Pacific", -8 * 3600},
+	"America/Vancouver":            {"America_Pacific", -8 * 3600},
+	"America/Halifax":              {"Atlantic", -4 * 3600},
+	"America/Sao_Paulo":            {"Brasilia", -3 * 3600},
+	"Asia/Calcutta":                {"India", 5*3600 + 30*60},
+	"Asia/Kolkata":                 {"India", 5*3600 + 30*60},
+	"Asia/Shanghai":                {"China", 8 * 3600},
+	"Asia/Tokyo":                   {"Japan", 9 * 3600},
+	"Australia/Melbourne":          {"Australia_Eastern", 10 * 3600},
+	"Australia/Sydney":             {"Australia_Eastern", 10 * 3600},
+	"Europe/Amsterdam":             {"Europe_Central", 3600},
+	"Europe/Berlin":                {"Europe_Central", 3600},
+	"Europe/Brussels":              {"Europe_Central", 3600},
+	"Europe/Budapest":              {"Europe_Central", 3600},
+	"Europe/Copenhagen":            {"Europe_Central", 3600},
+	"Europe/Madrid":                {"Europe_Central", 3600},
+	"Europe/Oslo":                  {"Europe_Central", 3600},
+	"Europe/Paris":                 {"Europe_Central", 3600},
+	"Europe/Prague":                {"Europe_Central", 3600},
+	"Europe/Rome":                  {"Europe_Central", 3600},
+	"Europe/Stockholm":             {"Europe_Central", 3600},
+	"Europe/Vienna":                {"Europe_Central", 3600},
+	"Europe/Warsaw":                {"Europe_Central", 3600},
+	"Europe/Zurich":                {"Europe_Central", 3600},
+	"Europe/Athens":                {"Europe_Eastern", 2 * 3600},
+	"Europe/Bucharest":             {"Europe_Eastern", 2 * 3600},
+	"Europe/Helsinki":              {"Europe_Eastern", 2 * 3600},
+	"Europe/Kiev":                  {"Europe_Eastern", 2 * 3600},
+	"Europe/Sofia":                 {"Europe_Eastern", 2 * 3600},
+	"Atlantic/Canary":              {"Europe_Western", 0},
+	"Europe/Lisbon":                {"Europe_Western", 0},
+	"Europe/Moscow":                {"Moscow", 3 * 3600},
+	"Pacific/Honolulu":             {"Hawaii_Aleutian", -10 * 3600},
+}
+
+// zoneName returns the long name of the zone of t, such as "Pacific
+// Daylight Time", or its generic name, such as "Pacific Time".
+func (l *Locale) zoneName(t time.Time, generic bool) (string, bool) {
+	mz, ok := metaZones[t.Location().String()]
+	if !ok {
+		return "", false
+	}
+	names, ok := l.ZoneNames[mz.name]
+	if !ok {
+		return "", false
+	}
+	name := names[0]
+	if _, offset := t.Zone(); generic {
+		name = names[2]
+	} else if offset != mz.standard && names[1] != "" {
+		name = names[1]
+	}
+	return name, true
+}
+
+// shortGenericZoneName returns the short generic name of the zone of t,
+// such as "PT". Like CLDR, only some locales have such names; others fall
+// back to the abbreviation of the zone, such as "PDT".
+func (l *Locale) shortGenericZoneName(t time.Time) (string, bool) {
+	mz, ok := metaZones[t.Location().String()]
+	if !ok {
+		return "", false
+	}
+	name, ok := l.ZoneNamesShort[mz.name]
+	return name, ok
+}
+
+// exemplarCity returns the city of an IANA time zone ID, such as
+// "Los Angeles" for "America/Los_Angeles".
+func exemplarCity(t time.Time) (string, bool) {
+	id := t.Location().String()
+	i := strings.LastIndexByte(id, '/')
+	if i < 0 {
+		return "", false
+	}
+	return strings.Replace(id[i+1:], "_", " ", -1), true
+}
@@ -0,0 +1,59 @@
+package datetime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestZoneNames(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+	winter := time.Date(2020, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2020, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	for idx, tc := range []struct {
+		lang     string
+		pattern  string
+		t        time.Time
+		expected string
+	}{
+		{"en", "HH:mm zzzz", winter.In(la), "04:00 Pacific Standard Time"},
+		{"en", "HH:mm zzzz", summer.In(la), "05:00 Pacific Daylight Time"},
+		{"en", "HH:mm z", summer.In(la), "05:00 PDT"},
+		{"en", "vvvv", summer.In(la), "Pacific Time"},
+		{"en", "h:mm a v", winter.In(newYork), "7:00 AM ET"},
+		{"en", "v", summer.In(la), "PT"},
+		{"en-GB", "HH:mm v", winter.In(newYork), "07:00 EST"},
+		{"en", "v", summer.In(berlin), "CEST"},
+		{"en", "VV / VVV", summer.In(la), "America/Los_Angeles / Los Angeles"},
+		{"en-GB", "zzzz", summer.In(berlin), "Central European Summer Time"},
+		{"en", "zzzz / vvvv", winter.In(kolkata), "India Standard Time / India Standard Time"},
+		{"de", "zzzz", winter.In(berlin), "Mitteleuropäische Normalzeit"},
+		{"de", "vvvv", summer.In(la), "Nordamerikanische Westküstenzeit"},
+		{"fr", "zzzz", summer.In(berlin), "heure d’été d’Europe centrale"},
+		{"ja", "zzzz", summer.In(berlin), "GMT+02:00"},
+		{"en", "zzzz", winter.In(time.FixedZone("XST", 3600)), "GMT+01:00"},
+		{"en", "VVV", winter, "GMT"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			p, err := Compile(tc.pattern)
+			require.NoError(err)
+			loc, err := LocaleFor(language.MustParse(tc.lang))
+			require.NoError(err)
+			require.Equal(tc.expected, p.Format(loc, tc.t))
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const maxExp = 1000
+
+// Decimal is an exact base 10 number that remembers how many fraction
+// digits are visible, so "1.50" and "1.5" are different values.
+type Decimal struct {
+	Neg  bool
+	Int  string // integer digits without leading zeros, "0" for zero
+	Frac string // visible fraction digits, including trailing zeros
+}
+
+// New converts any Go numeric value, *big.Int, *big.Float or decimal
+// string to a Decimal.
+func New(value interface{}) (Decimal, error) {
+	switch x := value.(type) {
+	case Decimal:
+		return x, nil
+	case int:
+		return Parse(strconv.FormatInt(int64(x), 10))
+	case int64:
+		return Parse(strconv.FormatInt(x, 10))
+	case uint64:
+		return Parse(strconv.FormatUint(x, 10))
+	case float64:
+		return fromFloat(x, 64)
+	case float32:
+		return fromFloat(float64(x), 32)
+	case string:
+		return Parse(x)
+	case *big.Int:
+		if x != nil {
+			return Parse(x.String())
+		}
+	case *big.Float:
+		if x != nil && !x.IsInf() {
+			return Parse(x.Text('f', -1))
+		}
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Parse(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Parse(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32:
+		return fromFloat(v.Float(), 32)
+	case reflect.Float64:
+		return fromFloat(v.Float(), 64)
+	case reflect.String:
+		return Parse(v.String())
+	}
+	return Decimal{}, fmt.Errorf("expected number got: %T", value)
+}
+
+func fromFloat(f float64, bitSize int) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("expected finite number got: %v", f)
+	}
+	return Parse(strconv.FormatFloat(f, 'f', -1, bitSize))
+}
+
+// Parse converts a string such as "-1234.50" or "1.5e3" to a Decimal.
+func Parse(s string) (Decimal, error) {
+	d := Decimal{}
+	src := s
+	if strings.HasPrefix(s, "-") {
+		d.Neg = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.Atoi(s[i+1:]); err != nil || exp > maxExp || exp < -maxExp {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", src)
+		}
+		s = s[:i]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", src)
+	}
+
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+	switch {
+	case point < 0:
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	d.Int = strings.TrimLeft(digits[:point], "0")
+	if d.Int == "" {
+		d.Int = "0"
+	}
+	d.Frac = digits[point:]
+	if d.IsZero() {
+		d.Neg = false
+	}
+	return d, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// IsInteger reports whether every visible fraction digit is zero.
+func (d Decimal) IsInteger() bool {
+	return strings.Trim(d.Frac, "0") == ""
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Int == "0" && d.IsInteger()
+}
+
+// Abs returns d without its sign.
+func (d Decimal) Abs() Decimal {
+	d.Neg = false
+	return d
+}
+
+// Sub returns d - n, keeping the visible fraction digits of d.
+func (d Decimal) Sub(n int64) Decimal {
+	if n == 0 {
+		return d
+	}
+	scale := len(d.Frac)
+	x, _ := new(big.Int).SetString(d.Int+d.Frac, 10)
+	if d.Neg {
+		x.Neg(x)
+	}
+	y := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	y.Mul(y, big.NewInt(n))
+	x.Sub(x, y)
+
+	result := Decimal{Neg: x.Sign() < 0}
+	digits := new(big.Int).Abs(x).String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	result.Int = strings.TrimLeft(digits[:len(digits)-scale], "0")
+	if result.Int == "" {
+		result.Int = "0"
+	}
+	result.Frac = digits[len(digits)-scale:]
+	return result
+}
+
+// Mul returns d * e, keeping the visible fraction digits of both.
+func (d Decimal) Mul(e Decimal) Decimal {
+	scale := len(d.Frac) + len(e.Frac)
+	x, _ := new(big.Int).SetString(d.Int+d.Frac, 10)
+	y, _ := new(big.Int).SetString(e.Int+e.Frac, 10)
+	x.Mul(x, y)
+
+	digits := x.String()
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	result := Decimal{
+		Neg:  d.Neg != e.Neg && x.Sign() != 0,
+		Int:  strings.TrimLeft(digits[:len(digits)-scale], "0"),
+		Frac: digits[len(digits)-scale:],
+	}
+	if result.Int == "" {
+		result.Int = "0"
+	}
+	return result
+}
+
+// Round returns d rounded half-even to at most scale fraction digits.
+func (d Decimal) Round(scale int) Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if len(d.Frac) <= scale {
+		return d
+	}
+
+	kept := []byte(d.Int + d.Frac[:scale])
+	dropped := d.Frac[scale:]
+	up := dropped[0] > '5' ||
+		dropped[0] == '5' && (strings.Trim(dropped[1:], "0") != "" || (kept[len(kept)-1]-'0')%2 == 1)
+	if up {
+		i := len(kept) - 1
+		for ; i >= 0 && kept[i] == '9'; i-- {
+			kept[i] = '0'
+		}
+		if i < 0 {
+			kept = append([]byte{'1'}, kept...)
+		} else {
+			kept[i]++
+		}
+	}
+
+	point := len(kept) - scale
+	result := Decimal{
+		Neg:  d.Neg,
+		Int:  strings.TrimLeft(string(kept[:point]), "0"),
+		Frac: string(kept[point:]),
+	}
+	if result.Int == "" {
+		result.Int = "0"
+	}
+	if result.IsZero() {
+		result.Neg = false
+	}
+	return result
+}
+
+// Shift returns d multiplied by 10^n.
+func (d Decimal) Shift(n int) Decimal {
+	if n == 0 {
+		return d
+	}
+	digits := d.Int + d.Frac
+	point := len(d.Int) + n
+	switch {
+	case point < 0:
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	case point > len(digits):
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	result := Decimal{
+		Neg:  d.Neg,
+		Int:  strings.TrimLeft(digits[:point], "0"),
+		Frac: digits[point:],
+	}
+	if result.Int == "" {
+		result.Int = "0"
+	}
+	return result
+}
+
+// String returns d using ASCII digits, for example "-1234.50".
+func (d Decimal) String() string {
+	var b strings.Builder
+	if d.Neg {
+		b.WriteByte('-')
+	}
+	b.WriteString(d.Int)
+	if d.Frac != "" {
+		b.WriteByte('.')
+		b.WriteString(d.Frac)
+	}
+	return b.String()
+}
@@ -0,0 +1,207 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+type count uint16
+
+func TestNew(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	for idx, tc := range []struct {
+		value    interface{}
+		expected Decimal
+	}{
+		{0, Decimal{Int: "0"}},
+		{-42, Decimal{Neg: true, Int: "42"}},
+		{int8(-8), Decimal{Neg: true, Int: "8"}},
+		{int64(math.MaxInt64), Decimal{Int: "9223372036854775807"}},
+		{uint(7), Decimal{Int: "7"}},
+		{uint64(math.MaxUint64), Decimal{Int: "18446744073709551615"}},
+		{count(12), Decimal{Int: "12"}},
+		{1.5, Decimal{Int: "1", Frac: "5"}},
+		{1.0, Decimal{Int: "1"}},
+		{float32(0.25), Decimal{Int: "0", Frac: "25"}},
+		{"1.0", Decimal{Int: "1", Frac: "0"}},
+		{"-0012.340", Decimal{Neg: true, Int: "12", Frac: "340"}},
+		{".5", Decimal{Int: "0", Frac: "5"}},
+		{"1.5e3", Decimal{Int: "1500"}},
+		{"15e-3", Decimal{Int: "0", Frac: "015"}},
+		{"-0.0", Decimal{Int: "0", Frac: "0"}},
+		{huge, Decimal{Int: "123456789012345678901234567890"}},
+		{big.NewFloat(2.5), Decimal{Int: "2", Frac: "5"}},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := New(tc.value)
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for idx, value := range []interface{}{
+		nil, "", ".", "1.2.3", "1e", "1e99999", "12abc", "--1",
+		math.NaN(), math.Inf(1), true, []int{1},
+	} {
+		value := value
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			_, err := New(value)
+			require.Error(err)
+		})
+	}
+}
+
+func TestSub(t *testing.T) {
+	for idx, tc := range []struct {
+		value    string
+		n        int64
+		expected string
+	}{
+		{"5", 1, "4"},
+		{"1", 1, "0"},
+		{"0", 1, "-1"},
+		{"1.50", 1, "0.50"},
+		{"0.5", 1, "-0.5"},
+		{"-2.25", 3, "-5.25"},
+		{"123456789012345678901234567890", 1, "123456789012345678901234567889"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := Parse(tc.value)
+			require.NoError(err)
+			require.Equal(tc.expected, d.Sub(tc.n).String())
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	for idx, tc := range []struct {
+		value    string
+		scale    int
+		expected string
+	}{
+		{"1.5", 3, "1.5"},
+		{"1.2345", 3, "1.234"},
+		{"1.2355", 3, "1.236"},
+		{"1.23451", 3, "1.235"},
+		{"2.5", 0, "2"},
+		{"3.5", 0, "4"},
+		{"9.99", 1, "10.0"},
+		{"-0.4", 0, "0"},
+		{"-1234.56", 0, "-1235"},
+		{"0.005", 2, "0.00"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := Parse(tc.value)
+			require.NoError(err)
+			require.Equal(tc.expected, d.Round(tc.scale).String())
+		})
+	}
+}
+
+func TestShift(t *testing.T) {
+	for idx, tc := range []struct {
+		value    string
+		n        int
+		expected string
+	}{
+		{"0.5", 2, "50"},
+		{"0.125", 2, "12.5"},
+		{"-1.5", 3, "-1500"},
+		{"15", -3, "0.015"},
+		{"12.5", -1, "1.25"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := Parse(tc.value)
+			require.NoError(err)
+			require.Equal(tc.expected, d.Shift(tc.n).String())
+		})
+	}
+}
+
+func TestMul(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b     string
+		expected string
+	}{
+		{"12", "3", "36"},
+		{"1.5", "0.5", "0.75"},
+		{"-2.50", "4", "-10.00"},
+		{"-2", "-0.1", "0.2"},
+		{"0", "-3", "0"},
+		{"0.01", "0.01", "0.0001"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			a, err := Parse(tc.a)
+			require.NoError(err)
+			b, err := Parse(tc.b)
+			require.NoError(err)
+			require.Equal(tc.expected, a.Mul(b).String())
+		})
+	}
+}
+
+func TestPluralForm(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		ordinal  bool
+		value    interface{}
+		expected plural.Form
+	}{
+		{"en", false, 1, plural.One},
+		{"en", false, -1, plural.One},
+		{"en", false, "1.0", plural.Other},
+		{"en", false, 1.5, plural.Other},
+		{"en", false, int64(1), plural.One},
+		{"en", false, uint(2), plural.Other},
+		{"fr", false, 1.5, plural.One},
+		{"fr", false, "0.0", plural.One},
+		{"pt-PT", false, 1, plural.One},
+		{"ru", false, 21, plural.One},
+		{"ru", false, 1000001, plural.One},
+		{"ru", false, "12345678901234567891", plural.One},
+		{"ru", false, 11, plural.Many},
+		{"ru", false, 1.5, plural.Other},
+		{"en", true, 22, plural.Two},
+		{"en", true, 113, plural.Other},
+		{"en", true, "123456789012345678903", plural.Few},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := New(tc.value)
+			require.NoError(err)
+
+			rules := plural.Cardinal
+			if tc.ordinal {
+				rules = plural.Ordinal
+			}
+			require.Equal(tc.expected, d.PluralForm(rules, language.MustParse(tc.lang)))
+		})
+	}
+}
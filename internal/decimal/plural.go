@@ -0,0 +1,28 @@
+package decimal
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// PluralForm selects the plural category of the absolute value of d.
+func (d Decimal) PluralForm(rules *plural.Rules, lang language.Tag) plural.Form {
+	// The rules only need i modulo 10^6 and f modulo 10^2, but must still
+	// be able to tell that larger values are not equal to small ones.
+	i := approximateInt(d.Int, 6)
+	f := approximateInt(d.Frac, 2)
+	return rules.MatchPlural(lang, i, len(d.Frac), 0, f, 0)
+}
+
+func approximateInt(digits string, n int) int {
+	digits = strings.TrimLeft(digits, "0")
+	if len(digits) <= n {
+		x, _ := strconv.Atoi("0" + digits)
+		return x
+	}
+	x, _ := strconv.Atoi("1" + digits[len(digits)-n:])
+	return x
+}
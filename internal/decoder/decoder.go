@@ -1,6 +1,10 @@
 package decoder
 
-import "unicode/utf8"
+import (
+	"unicode/utf8"
+
+	"github.com/sjansen/messageformat/ast"
+)
 
 type Decoder struct {
 	src string
@@ -10,6 +14,10 @@ type Decoder struct {
 	nextSize int
 	currRune rune
 	nextRune rune
+
+	line       int
+	byteColumn int
+	runeColumn int
 }
 
 func New(s string) *Decoder {
@@ -21,6 +29,10 @@ func New(s string) *Decoder {
 		currSize: 0,
 		nextRune: ch,
 		nextSize: size,
+
+		line:       1,
+		byteColumn: 1,
+		runeColumn: 1,
 	}
 }
 
@@ -33,6 +45,15 @@ func (d *Decoder) Decode() bool {
 	d.currSize = d.nextSize
 	d.idx += d.currSize
 
+	if d.currRune == '\n' {
+		d.line++
+		d.byteColumn = 1
+		d.runeColumn = 1
+	} else {
+		d.byteColumn += d.currSize
+		d.runeColumn++
+	}
+
 	ch, size := utf8.DecodeRuneInString(d.src[d.idx:])
 	d.nextRune = ch
 	d.nextSize = size
@@ -44,6 +65,30 @@ func (d *Decoder) Decoded() rune {
 	return d.currRune
 }
 
+// Offset returns the byte offset of the next rune.
+func (d *Decoder) Offset() int {
+	return d.idx
+}
+
+// Slice returns the source between two offsets.
+func (d *Decoder) Slice(begin, end int) string {
+	return d.src[begin:end]
+}
+
+func (d *Decoder) EOF() bool {
+	return d.nextSize < 1
+}
+
 func (d *Decoder) Peek() rune {
 	return d.nextRune
 }
+
+// Position returns the location of the next rune, which is
+// also the location immediately after the last decoded rune.
+func (d *Decoder) Position() ast.Position {
+	return ast.Position{
+		Line:       d.line,
+		ByteColumn: d.byteColumn,
+		RuneColumn: d.runeColumn,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/sjansen/messageformat/ast"
 )
 
 func TestDecoder(t *testing.T) {
@@ -12,8 +14,33 @@ func TestDecoder(t *testing.T) {
 	d := New("cão")
 	expected := []rune{'c', 'ã', 'o'}
 	for _, ch := range expected {
+		require.False(d.EOF())
 		require.True(d.Decode())
 		require.Equal(ch, d.Decoded())
 	}
+	require.True(d.EOF())
+	require.False(d.Decode())
+}
+
+func TestDecoderPosition(t *testing.T) {
+	require := require.New(t)
+
+	d := New("cão\nsim")
+	expected := []ast.Position{
+		{Line: 1, ByteColumn: 1, RuneColumn: 1},
+		{Line: 1, ByteColumn: 2, RuneColumn: 2},
+		{Line: 1, ByteColumn: 4, RuneColumn: 3},
+		{Line: 1, ByteColumn: 5, RuneColumn: 4},
+		{Line: 2, ByteColumn: 1, RuneColumn: 1},
+		{Line: 2, ByteColumn: 2, RuneColumn: 2},
+		{Line: 2, ByteColumn: 3, RuneColumn: 3},
+		{Line: 2, ByteColumn: 4, RuneColumn: 4},
+	}
+	for _, pos := range expected[:len(expected)-1] {
+		require.Equal(pos, d.Position())
+		require.True(d.Decode())
+	}
+	require.Equal(expected[len(expected)-1], d.Position())
 	require.False(d.Decode())
+	require.Equal(expected[len(expected)-1], d.Position())
 }
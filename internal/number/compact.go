@@ -0,0 +1,192 @@
+package number
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// compactUnit abbreviates numbers of at least 10^exp by plural category,
+// with "{0}" standing for the number divided by 10^exp.
+type compactUnit struct {
+	exp   int
+	forms map[plural.Form]string
+}
+
+type compactUnits struct {
+	short []compactUnit
+	long  []compactUnit
+}
+
+func forms(other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.Other: other}
+}
+
+func forms2(one, other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.One: one, plural.Other: other}
+}
+
+func forms4(one, few, many, other string) map[plural.Form]string {
+	return map[plural.Form]string{plural.One: one, plural.Few: few, plural.Many: many, plural.Other: other}
+}
+
+// allCompactUnits is keyed by base language and ordered by exponent.
+var allCompactUnits = map[string]*compactUnits{
+	"de": {
+		short: []compactUnit{
+			{6, forms("{0}\u00a0Mio.")},
+			{9, forms("{0}\u00a0Mrd.")},
+			{12, forms("{0}\u00a0Bio.")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} Tausend")},
+			{6, forms2("{0} Million", "{0} Millionen")},
+			{9, forms2("{0} Milliarde", "{0} Milliarden")},
+			{12, forms2("{0} Billion", "{0} Billionen")},
+		},
+	},
+	"en": {
+		short: []compactUnit{
+			{3, forms("{0}K")},
+			{6, forms("{0}M")},
+			{9, forms("{0}B")},
+			{12, forms("{0}T")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} thousand")},
+			{6, forms("{0} million")},
+			{9, forms("{0} billion")},
+			{12, forms("{0} trillion")},
+		},
+	},
+	"es": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0mil")},
+			{6, forms("{0}\u00a0M")},
+			{12, forms("{0}\u00a0B")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} mil")},
+			{6, forms2("{0} millón", "{0} millones")},
+			{12, forms2("{0} billón", "{0} billones")},
+		},
+	},
+	"fr": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0k")},
+			{6, forms("{0}\u00a0M")},
+			{9, forms("{0}\u00a0Md")},
+			{12, forms("{0}\u00a0Bn")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} mille")},
+			{6, forms2("{0} million", "{0} millions")},
+			{9, forms2("{0} milliard", "{0} milliards")},
+			{12, forms2("{0} billion", "{0} billions")},
+		},
+	},
+	"it": {
+		short: []compactUnit{
+			{6, forms("{0}\u00a0Mln")},
+			{9, forms("{0}\u00a0Mrd")},
+			{12, forms("{0}\u00a0Bln")},
+		},
+		long: []compactUnit{
+			{3, forms2("mille", "{0} mila")},
+			{6, forms2("{0} milione", "{0} milioni")},
+			{9, forms2("{0} miliardo", "{0} miliardi")},
+			{12, forms2("{0} mille miliardi", "{0} mila miliardi")},
+		},
+	},
+	"ja": {
+		short: []compactUnit{{4, forms("{0}万")}, {8, forms("{0}億")}, {12, forms("{0}兆")}},
+		long:  []compactUnit{{4, forms("{0}万")}, {8, forms("{0}億")}, {12, forms("{0}兆")}},
+	},
+	"nl": {
+		short: []compactUnit{
+			{3, forms("{0}K")},
+			{6, forms("{0}\u00a0mln.")},
+			{9, forms("{0}\u00a0mld.")},
+			{12, forms("{0}\u00a0bln.")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} duizend")},
+			{6, forms("{0} miljoen")},
+			{9, forms("{0} miljard")},
+			{12, forms("{0} biljoen")},
+		},
+	},
+	"pl": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0tys.")},
+			{6, forms("{0}\u00a0mln")},
+			{9, forms("{0}\u00a0mld")},
+			{12, forms("{0}\u00a0bln")},
+		},
+		long: []compactUnit{
+			{3, forms4("{0} tysiąc", "{0} tysiące", "{0} tysięcy", "{0} tysiąca")},
+			{6, forms4("{0} milion", "{0} miliony", "{0} milionów", "{0} miliona")},
+			{9, forms4("{0} miliard", "{0} miliardy", "{0} miliardów", "{0} miliarda")},
+			{12, forms4("{0} bilion", "{0} biliony", "{0} bilionów", "{0} biliona")},
+		},
+	},
+	"pt": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0mil")},
+			{6, forms("{0}\u00a0mi")},
+			{9, forms("{0}\u00a0bi")},
+			{12, forms("{0}\u00a0tri")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} mil")},
+			{6, forms2("{0} milhão", "{0} milhões")},
+			{9, forms2("{0} bilhão", "{0} bilhões")},
+			{12, forms2("{0} trilhão", "{0} trilhões")},
+		},
+	},
+	"ru": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0тыс.")},
+			{6, forms("{0}\u00a0млн")},
+			{9, forms("{0}\u00a0млрд")},
+			{12, forms("{0}\u00a0трлн")},
+		},
+		long: []compactUnit{
+			{3, forms4("{0} тысяча", "{0} тысячи", "{0} тысяч", "{0} тысячи")},
+			{6, forms4("{0} миллион", "{0} миллиона", "{0} миллионов", "{0} миллиона")},
+			{9, forms4("{0} миллиард", "{0} миллиарда", "{0} миллиардов", "{0} миллиарда")},
+			{12, forms4("{0} триллион", "{0} триллиона", "{0} триллионов", "{0} триллиона")},
+		},
+	},
+	"sv": {
+		short: []compactUnit{
+			{3, forms("{0}\u00a0tn")},
+			{6, forms("{0}\u00a0mn")},
+			{9, forms("{0}\u00a0md")},
+			{12, forms("{0}\u00a0bn")},
+		},
+		long: []compactUnit{
+			{3, forms("{0} tusen")},
+			{6, forms2("{0} miljon", "{0} miljoner")},
+			{9, forms2("{0} miljard", "{0} miljarder")},
+			{12, forms2("{0} biljon", "{0} biljoner")},
+		},
+	},
+	"zh": {
+		short: []compactUnit{{4, forms("{0}万")}, {8, forms("{0}亿")}, {12, forms("{0}万亿")}},
+		long:  []compactUnit{{4, forms("{0}万")}, {8, forms("{0}亿")}, {12, forms("{0}万亿")}},
+	},
+}
+
+// compactUnitsFor returns the short or long compact units of lang,
+// falling back to English.
+func compactUnitsFor(lang language.Tag, long bool) []compactUnit {
+	base, _ := lang.Base()
+	units, ok := allCompactUnits[base.String()]
+	if !ok {
+		units = allCompactUnits["en"]
+	}
+	if long {
+		return units.long
+	}
+	return units.short
+}
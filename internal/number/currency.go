@@ -0,0 +1,124 @@
+package number
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// currencyPatterns place the currency sign "¤" before or after the
+// number. Locales that are not listed use the English placement.
+var currencyPatterns = map[string][2]string{
+	"ar":     {"", "\u00a0¤"},
+	"bg":     {"", "\u00a0¤"},
+	"cs":     {"", "\u00a0¤"},
+	"da":     {"", "\u00a0¤"},
+	"de":     {"", "\u00a0¤"},
+	"de-AT":  {"¤\u00a0", ""},
+	"de-CH":  {"¤\u00a0", ""},
+	"el":     {"", "\u00a0¤"},
+	"en":     {"¤", ""},
+	"es":     {"", "\u00a0¤"},
+	"es-419": {"¤", ""},
+	"es-MX":  {"¤", ""},
+	"es-US":  {"¤", ""},
+	"et":     {"", "\u00a0¤"},
+	"fi":     {"", "\u00a0¤"},
+	"fr":     {"", "\u00a0¤"},
+	"fr-CH":  {"", "\u00a0¤"},
+	"hr":     {"", "\u00a0¤"},
+	"hu":     {"", "\u00a0¤"},
+	"it":     {"", "\u00a0¤"},
+	"it-CH":  {"¤\u00a0", ""},
+	"lt":     {"", "\u00a0¤"},
+	"lv":     {"", "\u00a0¤"},
+	"nb":     {"", "\u00a0¤"},
+	"nl":     {"¤\u00a0", ""},
+	"pl":     {"", "\u00a0¤"},
+	"pt":     {"¤\u00a0", ""},
+	"pt-PT":  {"", "\u00a0¤"},
+	"ro":     {"", "\u00a0¤"},
+	"ru":     {"", "\u00a0¤"},
+	"sk":     {"", "\u00a0¤"},
+	"sl":     {"", "\u00a0¤"},
+	"sr":     {"", "\u00a0¤"},
+	"sv":     {"", "\u00a0¤"},
+	"uk":     {"", "\u00a0¤"},
+	"vi":     {"", "\u00a0¤"},
+}
+
+// CurrencyDisplay selects how a currency is shown.
+type CurrencyDisplay int
+
+const (
+	SymbolDisplay       CurrencyDisplay = iota // "$", "US$" or "€"
+	NarrowSymbolDisplay                        // "$" or "€"
+	ISOCodeDisplay                             // "USD" or "EUR"
+)
+
+// Currency returns the currency format of lang for unit, rounded to the
+// unit's ISO 4217 minor units.
+func Currency(lang language.Tag, unit currency.Unit, display CurrencyDisplay) *Format {
+	digits, _ := currency.Standard.Rounding(unit)
+	symbol := currencySymbol(lang, unit, display)
+
+	pattern := currencyPattern(lang)
+	prefix, suffix := pattern[0], pattern[1]
+	// Separate symbols such as "CHF" from the digits they touch.
+	if strings.HasSuffix(prefix, "¤") && endsWithLetter(symbol) {
+		prefix += "\u00a0"
+	}
+	if strings.HasPrefix(suffix, "¤") && startsWithLetter(symbol) {
+		suffix = "\u00a0" + suffix
+	}
+
+	f := Decimal(lang)
+	f.MinFracDigits = digits
+	f.MaxFracDigits = digits
+	f.Prefix = strings.Replace(prefix, "¤", symbol, 1)
+	f.Suffix = strings.Replace(suffix, "¤", symbol, 1)
+	return f
+}
+
+func currencySymbol(lang language.Tag, unit currency.Unit, display CurrencyDisplay) string {
+	switch display {
+	case NarrowSymbolDisplay:
+		return message.NewPrinter(lang).Sprint(currency.NarrowSymbol(unit))
+	case ISOCodeDisplay:
+		return unit.String()
+	}
+	return message.NewPrinter(lang).Sprint(currency.Symbol(unit))
+}
+
+// DefaultCurrency returns the currency used in the region of lang.
+func DefaultCurrency(lang language.Tag) currency.Unit {
+	unit, _ := currency.FromTag(lang)
+	return unit
+}
+
+func currencyPattern(lang language.Tag) [2]string {
+	for tag := lang; tag != language.Und; tag = tag.Parent() {
+		if pattern, ok := currencyPatterns[tag.String()]; ok {
+			return pattern
+		}
+	}
+	base, _ := lang.Base()
+	if pattern, ok := currencyPatterns[base.String()]; ok {
+		return pattern
+	}
+	return currencyPatterns["en"]
+}
+
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+func endsWithLetter(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsLetter(r)
+}
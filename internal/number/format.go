@@ -0,0 +1,303 @@
+// Package number renders decimals using locale symbols and CLDR style
+// patterns.
+package number
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+// Notation selects how the magnitude of a number is shown.
+type Notation int
+
+const (
+	SimpleNotation       Notation = iota // "1,234,000"
+	ScientificNotation                   // "1.234E6"
+	EngineeringNotation                  // "1.234E6", with exponents in multiples of three
+	CompactShortNotation                 // "1.2M"
+	CompactLongNotation                  // "1.2 million"
+)
+
+// SignDisplay selects when a sign is shown.
+type SignDisplay int
+
+const (
+	SignAuto       SignDisplay = iota // "-1", "0", "1"
+	SignAlways                        // "-1", "+0", "+1"
+	SignNever                         // "1", "0", "1"
+	SignExceptZero                    // "-1", "0", "+1"
+)
+
+// Format describes how to render a number.
+type Format struct {
+	Symbols        *Symbols
+	MinIntDigits   int
+	MinFracDigits  int
+	MaxFracDigits  int
+	MinSigDigits   int
+	MaxSigDigits   int // overrides the fraction digit limits when positive
+	MinRoundDigits int // significant digits kept even past MaxFracDigits
+	Grouping       bool
+	MinGrouping    int              // digits needed left of the first separator, at least one
+	Scale          int              // power of ten the value is multiplied by before rounding
+	Multiplier     *decimal.Decimal // applied after Scale, if set
+	Notation       Notation
+	MinExpDigits   int
+	Sign           SignDisplay
+	Prefix         string
+	Suffix         string
+	NegPrefix      string // replace the sign and affixes of negative numbers when either is set
+	NegSuffix      string
+	Unit           map[plural.Form]string // measure unit patterns by plural category, if set
+
+	lang language.Tag
+}
+
+// Decimal returns the default format of lang, "#,##0.###".
+func Decimal(lang language.Tag) *Format {
+	return &Format{
+		Symbols:       SymbolsFor(lang),
+		MinIntDigits:  1,
+		MaxFracDigits: 3,
+		Grouping:      true,
+		lang:          lang,
+	}
+}
+
+// Integer returns the integer format of lang, "#,##0".
+func Integer(lang language.Tag) *Format {
+	f := Decimal(lang)
+	f.MaxFracDigits = 0
+	return f
+}
+
+// Percent returns the percent format of lang, "#,##0%".
+func Percent(lang language.Tag) *Format {
+	f := Integer(lang)
+	f.Scale = 2
+	f.Prefix = f.Symbols.PercentPrefix
+	f.Suffix = f.Symbols.PercentSuffix
+	return f
+}
+
+// Round applies the scale, multiplier and digit limits of f to d. It
+// ignores the notation of f.
+func (f *Format) Round(d decimal.Decimal) decimal.Decimal {
+	return f.round(f.multiply(d))
+}
+
+func (f *Format) multiply(d decimal.Decimal) decimal.Decimal {
+	d = d.Shift(f.Scale)
+	if f.Multiplier != nil {
+		d = d.Mul(*f.Multiplier)
+	}
+	return d
+}
+
+func (f *Format) round(d decimal.Decimal) decimal.Decimal {
+	if f.MaxSigDigits > 0 {
+		d = roundSignificant(d, f.MaxSigDigits)
+	} else {
+		scale := f.MaxFracDigits
+		if keep := f.MinRoundDigits - magnitude(d); keep > scale {
+			scale = keep
+		}
+		d = d.Round(scale)
+	}
+
+	frac := strings.TrimRight(d.Frac, "0")
+	min := f.MinFracDigits
+	if f.MinSigDigits > 0 {
+		digits := strings.TrimLeft(d.Int+frac, "0")
+		if digits == "" {
+			digits = "0"
+		}
+		if n := len(frac) + f.MinSigDigits - len(digits); n > min {
+			min = n
+		}
+	}
+	if len(frac) < min {
+		frac += strings.Repeat("0", min-len(frac))
+	}
+	d.Frac = frac
+	return d
+}
+
+// Format renders d.
+func (f *Format) Format(d decimal.Decimal) string {
+	d = f.multiply(d)
+	pattern := "{0}"
+	exponent, scientific := 0, false
+	switch f.Notation {
+	case ScientificNotation, EngineeringNotation:
+		d, exponent = f.scientific(d)
+		scientific = true
+	case CompactShortNotation, CompactLongNotation:
+		d, pattern = f.compact(d)
+	default:
+		d = f.round(d)
+	}
+	sym := f.Symbols
+
+	prefix, suffix := f.Prefix, f.Suffix
+
+	var b strings.Builder
+	switch {
+	case d.Neg && f.NegPrefix+f.NegSuffix != "":
+		prefix, suffix = f.NegPrefix, f.NegSuffix
+	case d.Neg && f.Sign != SignNever:
+		b.WriteString(sym.Minus)
+	case !d.Neg && f.Sign == SignAlways,
+		!d.Neg && f.Sign == SignExceptZero && !d.IsZero():
+		b.WriteString("+")
+	}
+	b.WriteString(prefix)
+
+	var n strings.Builder
+	digits := d.Int
+	if len(digits) < f.MinIntDigits {
+		digits = strings.Repeat("0", f.MinIntDigits-len(digits)) + digits
+	} else if f.MinIntDigits == 0 && digits == "0" && d.Frac != "" {
+		digits = ""
+	}
+	grouping := f.Grouping && len(digits)-sym.PrimaryGroup >= f.MinGrouping
+	for i := range digits {
+		n.WriteString(sym.Digits[digits[i]-'0'])
+		if grouping && f.isGroupBoundary(len(digits)-i-1) {
+			n.WriteString(sym.Group)
+		}
+	}
+	if d.Frac != "" {
+		n.WriteString(sym.Decimal)
+		for i := range d.Frac {
+			n.WriteString(sym.Digits[d.Frac[i]-'0'])
+		}
+	}
+	if scientific {
+		n.WriteString("E")
+		if exponent < 0 {
+			n.WriteString(sym.Minus)
+			exponent = -exponent
+		}
+		s := strconv.Itoa(exponent)
+		if len(s) < f.MinExpDigits {
+			s = strings.Repeat("0", f.MinExpDigits-len(s)) + s
+		}
+		n.WriteString(sym.Localize(s))
+	}
+
+	number := strings.Replace(pattern, "{0}", n.String(), 1)
+	if f.Unit != nil {
+		number = strings.Replace(f.selectForm(f.Unit, d), "{0}", number, 1)
+	}
+	b.WriteString(number)
+	b.WriteString(suffix)
+	return b.String()
+}
+
+// scientific splits d into a rounded mantissa and a power of ten.
+func (f *Format) scientific(d decimal.Decimal) (decimal.Decimal, int) {
+	step := 1
+	if f.Notation == EngineeringNotation {
+		step = 3
+	}
+	exponent := 0
+	if !d.IsZero() {
+		exponent = floorDiv(magnitude(d)-1, step) * step
+	}
+	m := f.round(d.Shift(-exponent))
+	if magnitude(m) > step {
+		exponent += step
+		m = f.round(d.Shift(-exponent))
+	}
+	return m, exponent
+}
+
+// compact divides d by the largest compact unit of the locale it reaches,
+// returning the rounded quotient and the pattern of that unit.
+func (f *Format) compact(d decimal.Decimal) (decimal.Decimal, string) {
+	units := compactUnitsFor(f.lang, f.Notation == CompactLongNotation)
+	for {
+		var unit *compactUnit
+		for i := range units {
+			if units[i].exp < magnitude(d) {
+				unit = &units[i]
+			}
+		}
+		if unit == nil {
+			return f.round(d), "{0}"
+		}
+
+		m := f.round(d.Shift(-unit.exp))
+		// Rounding may reach the next unit, as with 999,999 and "1000K".
+		if rounded := m.Shift(unit.exp); magnitude(rounded) > magnitude(d) && unit != &units[len(units)-1] {
+			d = rounded
+			continue
+		}
+
+		return m, f.selectForm(unit.forms, m)
+	}
+}
+
+// selectForm returns the pattern of forms for the plural category of d,
+// or its other pattern.
+func (f *Format) selectForm(forms map[plural.Form]string, d decimal.Decimal) string {
+	base, _ := f.lang.Base()
+	rules, _ := language.Compose(base)
+	if pattern, ok := forms[d.PluralForm(plural.Cardinal, rules)]; ok {
+		return pattern
+	}
+	return forms[plural.Other]
+}
+
+// isGroupBoundary reports whether a group separator follows a digit with
+// remaining digits to its right.
+func (f *Format) isGroupBoundary(remaining int) bool {
+	primary, secondary := f.Symbols.PrimaryGroup, f.Symbols.SecondaryGroup
+	switch {
+	case primary <= 0 || remaining < primary:
+		return false
+	case remaining == primary:
+		return true
+	case secondary <= 0:
+		return (remaining-primary)%primary == 0
+	}
+	return (remaining-primary)%secondary == 0
+}
+
+// magnitude returns the number of integer digits of d, or the negated
+// number of zeros after the decimal point when d is below one.
+func magnitude(d decimal.Decimal) int {
+	if d.Int != "0" {
+		return len(d.Int)
+	}
+	frac := strings.TrimLeft(d.Frac, "0")
+	if frac == "" {
+		return 0
+	}
+	return len(frac) - len(d.Frac)
+}
+
+func roundSignificant(d decimal.Decimal, digits int) decimal.Decimal {
+	if d.IsZero() {
+		return d.Round(0)
+	}
+	scale := digits - magnitude(d)
+	if scale >= 0 {
+		return d.Round(scale)
+	}
+	return d.Shift(scale).Round(0).Shift(-scale)
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
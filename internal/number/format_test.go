@@ -0,0 +1,97 @@
+package number
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestSymbols(t *testing.T) {
+	require := require.New(t)
+
+	en := SymbolsFor(language.English)
+	require.Equal([10]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, en.Digits)
+	require.Equal(".", en.Decimal)
+	require.Equal(",", en.Group)
+	require.Equal("-", en.Minus)
+	require.Equal(3, en.PrimaryGroup)
+	require.Equal(3, en.SecondaryGroup)
+	require.Equal("", en.PercentPrefix)
+	require.Equal("%", en.PercentSuffix)
+
+	hi := SymbolsFor(language.Hindi)
+	require.Equal(3, hi.PrimaryGroup)
+	require.Equal(2, hi.SecondaryGroup)
+
+	tr := SymbolsFor(language.Turkish)
+	require.Equal("%", tr.PercentPrefix)
+	require.Equal("", tr.PercentSuffix)
+
+	ar := SymbolsFor(language.Arabic)
+	require.Equal("٣", ar.Digits[3])
+	require.Equal("٤٢", ar.Localize("42"))
+}
+
+func TestFormat(t *testing.T) {
+	for idx, tc := range []struct {
+		format   *Format
+		value    string
+		expected string
+	}{
+		{Decimal(language.English), "1234567.891", "1,234,567.891"},
+		{Decimal(language.English), "1234.56789", "1,234.568"},
+		{Decimal(language.English), "1.50", "1.5"},
+		{Decimal(language.English), "-0.0001", "0"},
+		{Decimal(language.English), "123456789012345678901234567890", "123,456,789,012,345,678,901,234,567,890"},
+		{Decimal(language.German), "-1234.5", "-1.234,5"},
+		{Decimal(language.French), "1234.5", "1\u00a0234,5"},
+		{Decimal(language.Hindi), "123456789", "12,34,56,789"},
+		{Decimal(language.Arabic), "1234.5", "١٬٢٣٤٫٥"},
+		{Decimal(language.Swedish), "-7", "\u22127"},
+		{Integer(language.English), "2.5", "2"},
+		{Integer(language.English), "3.5", "4"},
+		{Integer(language.English), "999.9", "1,000"},
+		{Percent(language.English), "0.256", "26%"},
+		{Percent(language.English), "-12.345", "-1,234%"},
+		{Percent(language.German), "0.5", "50\u00a0%"},
+		{Percent(language.Turkish), "0.5", "%50"},
+		{Currency(language.English, currency.USD, SymbolDisplay), "1234.5", "$1,234.50"},
+		{Currency(language.English, currency.USD, SymbolDisplay), "-0.125", "-$0.12"},
+		{Currency(language.English, currency.JPY, SymbolDisplay), "1234.5", "¥1,234"},
+		{Currency(language.German, currency.EUR, SymbolDisplay), "1234.5", "1.234,50\u00a0€"},
+		{Currency(language.MustParse("de-CH"), currency.CHF, SymbolDisplay), "1234.5", "CHF\u00a01’234.50"},
+		{Currency(language.BrazilianPortuguese, currency.BRL, SymbolDisplay), "1234.5", "R$\u00a01.234,50"},
+		{Currency(language.EuropeanPortuguese, currency.EUR, SymbolDisplay), "1234.5", "1\u00a0234,50\u00a0€"},
+		{Currency(language.MustParse("es-MX"), currency.MXN, SymbolDisplay), "1234.5", "$1,234.50"},
+		{Currency(language.English, currency.CAD, SymbolDisplay), "5", "CA$5.00"},
+		{Currency(language.English, currency.CAD, NarrowSymbolDisplay), "5", "$5.00"},
+		{Currency(language.English, currency.CAD, ISOCodeDisplay), "5", "CAD\u00a05.00"},
+		{Currency(language.German, currency.CAD, ISOCodeDisplay), "5", "5,00\u00a0CAD"},
+		{Currency(language.English, currency.MustParseISO("BHD"), SymbolDisplay), "1.23456", "BHD\u00a01.235"},
+		{&Format{Symbols: SymbolsFor(language.English), MinIntDigits: 3, MinFracDigits: 2, MaxFracDigits: 2}, "7", "007.00"},
+		{&Format{Symbols: SymbolsFor(language.English), MaxFracDigits: 2}, "0.5", ".5"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			require.Equal(tc.expected, tc.format.Format(d))
+		})
+	}
+}
+
+func TestDefaultCurrency(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(currency.USD, DefaultCurrency(language.English))
+	require.Equal(currency.EUR, DefaultCurrency(language.German))
+	require.Equal(currency.BRL, DefaultCurrency(language.Portuguese))
+	require.Equal(currency.GBP, DefaultCurrency(language.BritishEnglish))
+}
@@ -0,0 +1,63 @@
+package number
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+// ordinalPatterns hold the ordinal forms of each base language by plural
+// category, with "{0}" standing for the number.
+var ordinalPatterns = map[string]map[plural.Form]string{
+	"de": {plural.Other: "{0}."},
+	"en": {plural.One: "{0}st", plural.Two: "{0}nd", plural.Few: "{0}rd", plural.Other: "{0}th"},
+	"es": {plural.Other: "{0}.º"},
+	"fr": {plural.One: "{0}er", plural.Other: "{0}e"},
+	"it": {plural.Other: "{0}º"},
+	"ja": {plural.Other: "第{0}"},
+	"nl": {plural.Other: "{0}e"},
+	"pl": {plural.Other: "{0}."},
+	"pt": {plural.Other: "{0}º"},
+	"ru": {plural.Other: "{0}-й"},
+	"sv": {plural.One: "{0}:a", plural.Other: "{0}:e"},
+	"zh": {plural.Other: "第{0}"},
+}
+
+// OrdinalFormat renders integers as ordinal numbers such as "2nd" in
+// English or "2." in German.
+type OrdinalFormat struct {
+	integer  *Format
+	rules    language.Tag // base language for plural rules
+	patterns map[plural.Form]string
+}
+
+// NewOrdinal returns the ordinal format for lang. Ordinal forms are bundled
+// for de, en, es, fr, it, ja, nl, pl, pt, ru, sv and zh; other languages
+// are reported as an error rather than given English suffixes.
+func NewOrdinal(lang language.Tag) (*OrdinalFormat, error) {
+	// Unknown languages report a guessed base, such as "en" for "und",
+	// so match plural rules by base too.
+	base, _ := lang.Base()
+	patterns, ok := ordinalPatterns[base.String()]
+	if !ok {
+		return nil, fmt.Errorf("no ordinal data for language: %q", lang)
+	}
+	rules, _ := language.Compose(base)
+	return &OrdinalFormat{integer: Integer(lang), rules: rules, patterns: patterns}, nil
+}
+
+// Format renders the integer d as an ordinal number.
+func (o *OrdinalFormat) Format(d decimal.Decimal) (string, error) {
+	if !d.IsInteger() {
+		return "", fmt.Errorf("expected integer ordinal got: %s", d)
+	}
+	pattern, ok := o.patterns[d.PluralForm(plural.Ordinal, o.rules)]
+	if !ok {
+		pattern = o.patterns[plural.Other]
+	}
+	return strings.Replace(pattern, "{0}", o.integer.Format(d), 1), nil
+}
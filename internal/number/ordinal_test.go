@@ -0,0 +1,73 @@
+package number
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestOrdinal(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		value    string
+		expected string
+	}{
+		{"en", "1", "1st"},
+		{"en", "2", "2nd"},
+		{"en", "3", "3rd"},
+		{"en", "4", "4th"},
+		{"en", "11", "11th"},
+		{"en", "12", "12th"},
+		{"en", "13", "13th"},
+		{"en", "21", "21st"},
+		{"en", "102", "102nd"},
+		{"en", "1003", "1,003rd"},
+		{"en", "3.0", "3rd"},
+		{"de", "3", "3."},
+		{"fr", "1", "1er"},
+		{"fr", "2", "2e"},
+		{"es", "5", "5.º"},
+		{"pt", "5", "5º"},
+		{"nl", "5", "5e"},
+		{"sv", "1", "1:a"},
+		{"sv", "2", "2:a"},
+		{"sv", "3", "3:e"},
+		{"sv", "12", "12:e"},
+		{"ru", "7", "7-й"},
+		{"ja", "7", "第7"},
+		{"und", "22", "22nd"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			o, err := NewOrdinal(language.Make(tc.lang))
+			require.NoError(err)
+			actual, err := o.Format(d)
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestOrdinalErrors(t *testing.T) {
+	o, err := NewOrdinal(language.English)
+	require.NoError(t, err)
+	for _, value := range []string{"1.5", "2.01", "-0.5"} {
+		d, err := decimal.Parse(value)
+		require.NoError(t, err)
+		_, err = o.Format(d)
+		require.Error(t, err, value)
+	}
+
+	for _, lang := range []string{"ko", "tr", "hi"} {
+		_, err := NewOrdinal(language.Make(lang))
+		require.Error(t, err, lang)
+	}
+}
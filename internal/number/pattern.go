@@ -0,0 +1,318 @@
+package number
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// Pattern is a parsed DecimalFormat pattern such as "#,##0.00" or
+// "¤#,##0.00;(¤#,##0.00)".
+//
+// See https://unicode.org/reports/tr35/tr35-numbers.html#Number_Format_Patterns
+type Pattern struct {
+	prefix, suffix       []affixToken
+	negPrefix, negSuffix []affixToken
+	negative             bool // set when the pattern has a negative subpattern
+
+	minInt, minFrac, maxFrac int
+	minSig, maxSig           int
+	primary, secondary       int // grouping sizes, zero without grouping
+	notation                 Notation
+	minExp                   int
+	scale                    int
+}
+
+// affixToken is literal text, or a symbol replaced when formatting.
+type affixToken struct {
+	symbol rune // '¤', '%', '‰', '-', '+' or 0 for literal text
+	count  int  // repeated currency signs
+	text   string
+}
+
+const (
+	patternDigits  = "#0123456789@,."
+	patternSymbols = "%‰-+"
+)
+
+// ParsePattern parses a DecimalFormat pattern. Quoted text in the affixes
+// is literal, and two apostrophes stand for one.
+func ParsePattern(s string) (*Pattern, error) {
+	subpatterns, err := splitPattern(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &Pattern{}
+	if err := p.parse(subpatterns[0], false); err != nil {
+		return nil, err
+	}
+	if len(subpatterns) == 2 {
+		if err := p.parse(subpatterns[1], true); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// IsCurrency reports whether the pattern shows a currency sign.
+func (p *Pattern) IsCurrency() bool {
+	for _, affix := range [][]affixToken{p.prefix, p.suffix, p.negPrefix, p.negSuffix} {
+		for _, t := range affix {
+			if t.symbol == '¤' {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Format returns the format of lang described by p. The currency sign
+// shows unit as a symbol, "¤", an ISO code, "¤¤", or a narrow symbol,
+// "¤¤¤¤¤". The digits of the pattern apply to every currency.
+func (p *Pattern) Format(lang language.Tag, unit currency.Unit) *Format {
+	f := Decimal(lang)
+	f.MinIntDigits = p.minInt
+	f.MinFracDigits = p.minFrac
+	f.MaxFracDigits = p.maxFrac
+	f.MinSigDigits = p.minSig
+	f.MaxSigDigits = p.maxSig
+	f.Grouping = p.primary > 0
+	if f.Grouping && (p.primary != f.Symbols.PrimaryGroup || p.secondary != f.Symbols.SecondaryGroup) {
+		sym := *f.Symbols
+		sym.PrimaryGroup, sym.SecondaryGroup = p.primary, p.secondary
+		f.Symbols = &sym
+	}
+	f.Notation = p.notation
+	f.MinExpDigits = p.minExp
+	f.Scale = p.scale
+
+	f.Prefix = p.affix(p.prefix, lang, f.Symbols, unit)
+	f.Suffix = p.affix(p.suffix, lang, f.Symbols, unit)
+	if p.negative {
+		f.NegPrefix = p.affix(p.negPrefix, lang, f.Symbols, unit)
+		f.NegSuffix = p.affix(p.negSuffix, lang, f.Symbols, unit)
+	}
+	return f
+}
+
+func (p *Pattern) affix(tokens []affixToken, lang language.Tag, sym *Symbols, unit currency.Unit) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		switch t.symbol {
+		case 0:
+			b.WriteString(t.text)
+		case '¤':
+			display := SymbolDisplay
+			switch t.count {
+			case 2:
+				display = ISOCodeDisplay
+			case 5:
+				display = NarrowSymbolDisplay
+			}
+			b.WriteString(currencySymbol(lang, unit, display))
+		case '%':
+			b.WriteString(strings.TrimSpace(sym.PercentPrefix + sym.PercentSuffix))
+		case '-':
+			b.WriteString(sym.Minus)
+		default:
+			b.WriteRune(t.symbol)
+		}
+	}
+	return b.String()
+}
+
+// splitPattern separates the positive and negative subpatterns of s.
+func splitPattern(s string) ([]string, error) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\'':
+			quoted = !quoted
+		case s[i] == ';' && !quoted:
+			if strings.TrimSpace(s[i+1:]) == "" {
+				return []string{s[:i]}, nil
+			}
+			return []string{s[:i], s[i+1:]}, nil
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in number pattern: %q", s)
+	}
+	return []string{s}, nil
+}
+
+// parse reads one subpattern. Only the affixes of negative subpatterns
+// are used, as their digits must match the positive subpattern.
+func (p *Pattern) parse(s string, negative bool) error {
+	prefix, rest, err := parseAffix(s)
+	if err != nil {
+		return err
+	}
+	end := 0
+	for end < len(rest) && strings.IndexByte(patternDigits, rest[end]) >= 0 {
+		end++
+	}
+	body := rest[:end]
+	if end < len(rest) && rest[end] == 'E' {
+		end++
+		if end < len(rest) && rest[end] == '+' {
+			return fmt.Errorf("unsupported number pattern: %q", s)
+		}
+		start := end
+		for end < len(rest) && rest[end] == '0' {
+			end++
+		}
+		if end == start {
+			return fmt.Errorf("invalid number pattern: %q", s)
+		}
+		if !negative {
+			p.notation = ScientificNotation
+			p.minExp = end - start
+		}
+	}
+	suffix, rest, err := parseAffix(rest[end:])
+	if err != nil {
+		return err
+	}
+	if body == "" || rest != "" {
+		return fmt.Errorf("invalid number pattern: %q", s)
+	}
+
+	if negative {
+		p.negative = true
+		p.negPrefix, p.negSuffix = prefix, suffix
+		return nil
+	}
+	p.prefix, p.suffix = prefix, suffix
+	for _, t := range append(prefix, suffix...) {
+		switch {
+		case t.symbol == '%':
+			p.scale = 2
+		case t.symbol == '‰':
+			p.scale = 3
+		case t.symbol == '¤' && t.count != 1 && t.count != 2 && t.count != 5:
+			return fmt.Errorf("unsupported number pattern: %q", s)
+		}
+	}
+	if !p.parseDigits(body) {
+		return fmt.Errorf("invalid number pattern: %q", s)
+	}
+	return nil
+}
+
+// parseAffix reads literal text and symbols up to the first digit of s,
+// returning the rest of s.
+func parseAffix(s string) ([]affixToken, string, error) {
+	var tokens []affixToken
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, affixToken{text: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for s != "" {
+		r, n := utf8.DecodeRuneInString(s)
+		switch {
+		case strings.IndexByte(patternDigits, s[0]) >= 0:
+			flush()
+			return tokens, s, nil
+		case r == '\'':
+			if strings.HasPrefix(s, "''") {
+				literal.WriteByte('\'')
+				s = s[2:]
+				continue
+			}
+			s = s[1:]
+			for {
+				end := strings.IndexByte(s, '\'')
+				if end < 0 {
+					return nil, "", fmt.Errorf("unterminated quote in number pattern: %q", s)
+				}
+				literal.WriteString(s[:end])
+				s = s[end+1:]
+				if !strings.HasPrefix(s, "'") {
+					break
+				}
+				literal.WriteByte('\'')
+				s = s[1:]
+			}
+			continue
+		case r == '*':
+			return nil, "", fmt.Errorf("unsupported number pattern padding: %q", s)
+		case r == '¤':
+			flush()
+			count := 1
+			for strings.HasPrefix(s[count*n:], "¤") {
+				count++
+			}
+			tokens = append(tokens, affixToken{symbol: r, count: count})
+			s = s[count*n:]
+			continue
+		case strings.ContainsRune(patternSymbols, r):
+			flush()
+			tokens = append(tokens, affixToken{symbol: r})
+		default:
+			literal.WriteRune(r)
+		}
+		s = s[n:]
+	}
+	flush()
+	return tokens, s, nil
+}
+
+// parseDigits reads the integer and fraction digits of a pattern such as
+// "#,##0.00#" or the significant digits of one such as "@@#".
+func (p *Pattern) parseDigits(body string) bool {
+	intPart, fracPart := body, ""
+	if i := strings.IndexByte(body, '.'); i >= 0 {
+		intPart, fracPart = body[:i], body[i+1:]
+		if strings.IndexByte(fracPart, '.') >= 0 {
+			return false
+		}
+	}
+
+	groups := strings.Split(intPart, ",")
+	if len(groups) > 1 {
+		p.primary = len(groups[len(groups)-1])
+		if p.primary == 0 {
+			return false
+		}
+		p.secondary = p.primary
+		if len(groups) > 2 {
+			p.secondary = len(groups[len(groups)-2])
+		}
+	}
+	digits := strings.Join(groups, "")
+
+	if strings.IndexByte(digits, '@') >= 0 {
+		if fracPart != "" || strings.Contains(body, ".") {
+			return false
+		}
+		digits = strings.TrimLeft(digits, "#")
+		sig := strings.TrimRight(digits, "#")
+		if sig == "" || strings.Trim(sig, "@") != "" {
+			return false
+		}
+		p.minSig, p.maxSig = len(sig), len(digits)
+		p.minInt, p.maxFrac = 1, maxDigits
+		return true
+	}
+
+	optional := strings.TrimLeft(digits, "#")
+	if strings.Trim(optional, "0") != "" || strings.Trim(fracPart, "0#") != "" ||
+		strings.Contains(strings.TrimRight(fracPart, "#"), "#") {
+		return false
+	}
+	p.minInt = len(optional)
+	p.minFrac = len(strings.TrimRight(fracPart, "#"))
+	p.maxFrac = len(fracPart)
+	if p.notation == ScientificNotation && len(digits) == 3 && p.minInt == 1 {
+		p.notation = EngineeringNotation
+	}
+	return true
+}
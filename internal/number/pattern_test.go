@@ -0,0 +1,111 @@
+package number
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestPattern(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		pattern  string
+		value    string
+		expected string
+	}{
+		{"en", "#,##0.00", "1234.5", "1,234.50"},
+		{"en", "#,##0.00", "-0.006", "-0.01"},
+		{"de", "#,##0.00", "1234.5", "1.234,50"},
+		{"en", "0000", "42", "0042"},
+		{"en", "#.##", "0.5", ".5"},
+		{"en", "#,##0.0#", "3.14159", "3.14"},
+		{"en", "#,##,##0", "123456789", "12,34,56,789"},
+		{"hi", "#,##0", "123456789", "123,456,789"},
+		{"en", "#", "1234.5", "1234"},
+		{"en", "@@#", "3.14159", "3.14"},
+		{"en", "@@", "0.5", "0.50"},
+		{"en", "0.###E0", "123456", "1.235E5"},
+		{"en", "0.###E00", "0.00123", "1.23E-03"},
+		{"en", "##0.##E0", "123456", "123.46E3"},
+		{"en", "#,##0%", "0.256", "26%"},
+		{"fr", "#,##0.0 %", "0.256", "25,6 %"},
+		{"en", "#,##0‰", "0.256", "256‰"},
+		{"en", "'#'0 'o''clock'", "7", "#7 o'clock"},
+		{"en", "+#;-#", "7", "+7"},
+		{"en", "#,##0.00;(#,##0.00)", "-1234.5", "(1,234.50)"},
+		{"en", "#,##0.00;(#,##0.00)", "1234.5", "1,234.50"},
+		{"sv", "#;-#", "-7", "−7"},
+		{"ar", "#,##0.00", "1234.5", "١٬٢٣٤٫٥٠"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			p, err := ParsePattern(tc.pattern)
+			require.NoError(err)
+			require.False(p.IsCurrency())
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			actual := p.Format(language.Make(tc.lang), currency.Unit{}).Format(d)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestCurrencyPattern(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		pattern  string
+		unit     currency.Unit
+		value    string
+		expected string
+	}{
+		{"en", "¤#,##0.00", currency.USD, "1234.5", "$1,234.50"},
+		{"en", "¤#,##0.00", currency.JPY, "1234.5", "¥1,234.50"},
+		{"en", "¤¤ #,##0.00", currency.EUR, "1234.5", "EUR 1,234.50"},
+		{"en-CA", "¤¤¤¤¤#,##0", currency.USD, "5", "$5"},
+		{"de", "#,##0.00 ¤", currency.EUR, "1234.5", "1.234,50 €"},
+		{"en", "¤#,##0.00;(¤#,##0.00)", currency.USD, "-5", "($5.00)"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			p, err := ParsePattern(tc.pattern)
+			require.NoError(err)
+			require.True(p.IsCurrency())
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			actual := p.Format(language.Make(tc.lang), tc.unit).Format(d)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestPatternErrors(t *testing.T) {
+	for _, pattern := range []string{
+		"",
+		"%",
+		"#,##0.00 'units",
+		"#,##0.0.0",
+		"0#",
+		"0.0#0",
+		"#,",
+		"#,##0.05",
+		"@#@",
+		"@@.#",
+		"0.0E",
+		"0.0E+0",
+		"*x#,##0",
+		"¤¤¤#,##0",
+		"#,##0 items 0",
+	} {
+		_, err := ParsePattern(pattern)
+		require.Error(t, err, pattern)
+	}
+}
@@ -0,0 +1,296 @@
+package number
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+// Skeleton is a parsed ICU number skeleton such as "currency/EUR .00".
+//
+// Measure units are limited to common lengths, masses, volumes,
+// temperatures and digital sizes, such as "unit/kilogram" and
+// "measure-unit/length-meter". Their names are shown in de, en, es, fr, it,
+// nl, pt and ru, and as the CLDR root symbols elsewhere. Narrow units use
+// the short names, and currencies have no full names.
+//
+// See https://unicode-org.github.io/icu/userguide/format_parse/numbers/skeletons.html
+type Skeleton struct {
+	currency *currency.Unit
+	display  CurrencyDisplay
+	unit     string // "%" or "‰" for percent and permille
+	measure  string // core ID of a measure unit, such as "meter"
+	fullName bool
+	compact  bool
+	options  []func(f *Format)
+}
+
+// ParseSkeleton parses the stems of a number skeleton, separated by spaces.
+func ParseSkeleton(s string) (*Skeleton, error) {
+	sk := &Skeleton{}
+	precision := false
+	for _, token := range strings.Fields(s) {
+		parts := strings.Split(token, "/")
+		stem, options := parts[0], parts[1:]
+		ok := true
+		switch {
+		case len(options) > 0:
+			ok = sk.parseStemWithOptions(stem, options)
+		case sk.parseNotation(stem):
+		case sk.parsePrecision(stem):
+			precision = true
+		default:
+			ok = sk.parseStem(stem)
+		}
+		if !ok {
+			return nil, fmt.Errorf("unsupported number skeleton stem: %q", token)
+		}
+	}
+	if sk.currency != nil && (sk.measure != "" || sk.fullName) {
+		return nil, fmt.Errorf("unsupported number skeleton: %q", s)
+	}
+	// Compact numbers keep two significant digits by default, as in "1.2K".
+	if sk.compact && !precision {
+		sk.options = append(sk.options, func(f *Format) {
+			f.MinFracDigits = 0
+			f.MaxFracDigits = 0
+			f.MinRoundDigits = 2
+		})
+	}
+	return sk, nil
+}
+
+// Currency returns the unit of the currency stem, if any.
+func (sk *Skeleton) Currency() (currency.Unit, bool) {
+	if sk.currency == nil {
+		return currency.Unit{}, false
+	}
+	return *sk.currency, true
+}
+
+// Format returns the format of lang described by sk. Skeletons with a
+// currency stem render amounts in unit.
+func (sk *Skeleton) Format(lang language.Tag, unit currency.Unit) *Format {
+	var f *Format
+	if sk.currency != nil {
+		f = Currency(lang, unit, sk.display)
+	} else {
+		f = Decimal(lang)
+	}
+	if sk.unit != "" {
+		f.Prefix = strings.Replace(f.Symbols.PercentPrefix, "%", sk.unit, 1)
+		f.Suffix = strings.Replace(f.Symbols.PercentSuffix, "%", sk.unit, 1)
+	}
+	if sk.measure != "" {
+		f.Unit = unitForms(lang, sk.measure, sk.fullName)
+	}
+	for _, option := range sk.options {
+		option(f)
+	}
+	return f
+}
+
+func (sk *Skeleton) add(option func(f *Format)) bool {
+	sk.options = append(sk.options, option)
+	return true
+}
+
+func (sk *Skeleton) parseStemWithOptions(stem string, options []string) bool {
+	if len(options) != 1 {
+		return false
+	}
+	option := options[0]
+	switch stem {
+	case "currency":
+		unit, err := currency.ParseISO(option)
+		if err != nil {
+			return false
+		}
+		sk.currency = &unit
+		return true
+	case "measure-unit", "unit":
+		switch option {
+		case "percent", "concentr-percent":
+			sk.unit = "%"
+		case "permille", "concentr-permille":
+			sk.unit = "‰"
+		default:
+			unit, ok := parseMeasureUnit(option, stem == "measure-unit")
+			if !ok {
+				return false
+			}
+			sk.measure = unit
+		}
+		return true
+	case "integer-width":
+		if len(option) < 2 || (option[0] != '*' && option[0] != '+') || strings.Trim(option[1:], "0") != "" {
+			return false
+		}
+		digits := len(option) - 1
+		return sk.add(func(f *Format) { f.MinIntDigits = digits })
+	case "scale":
+		d, err := decimal.Parse(option)
+		if err != nil || d.Neg || d.IsZero() {
+			return false
+		}
+		return sk.add(func(f *Format) { f.Multiplier = &d })
+	}
+	return false
+}
+
+func (sk *Skeleton) parseNotation(stem string) bool {
+	notation := SimpleNotation
+	switch stem {
+	case "notation-simple":
+	case "compact-short", "K":
+		notation = CompactShortNotation
+		sk.compact = true
+	case "compact-long", "KK":
+		notation = CompactLongNotation
+		sk.compact = true
+	case "scientific":
+		notation = ScientificNotation
+	case "engineering":
+		notation = EngineeringNotation
+	default:
+		// Concise forms such as "E0" and "EE00" set the minimum exponent digits.
+		rest := strings.TrimPrefix(stem, "E")
+		notation = ScientificNotation
+		if strings.HasPrefix(rest, "E") {
+			rest = rest[1:]
+			notation = EngineeringNotation
+		}
+		if rest == "" || rest == stem || strings.Trim(rest, "0") != "" {
+			return false
+		}
+		digits := len(rest)
+		return sk.add(func(f *Format) {
+			f.Notation = notation
+			f.MinExpDigits = digits
+		})
+	}
+	return sk.add(func(f *Format) { f.Notation = notation })
+}
+
+// parsePrecision handles fraction digits such as ".00" and ".0#",
+// significant digits such as "@@#", and the named precision stems.
+func (sk *Skeleton) parsePrecision(stem string) bool {
+	switch stem {
+	case "precision-integer", ".":
+		return sk.add(func(f *Format) {
+			f.MinFracDigits = 0
+			f.MaxFracDigits = 0
+		})
+	case "precision-unlimited":
+		return sk.add(func(f *Format) { f.MaxFracDigits = maxDigits })
+	case "precision-currency-standard", "precision-currency-cash":
+		// Currency formats already round to the standard minor units.
+		return true
+	}
+
+	var prefix byte
+	switch {
+	case strings.HasPrefix(stem, "."):
+		prefix = '.'
+	case strings.HasPrefix(stem, "@"):
+		prefix = '@'
+	default:
+		return false
+	}
+	min, max, ok := parseDigits(stem[1:], prefix)
+	if !ok {
+		return false
+	}
+	if prefix == '@' {
+		min++
+		if max >= 0 {
+			max++
+		} else {
+			max = maxDigits
+		}
+		return sk.add(func(f *Format) {
+			f.MinFracDigits = 0
+			f.MinSigDigits = min
+			f.MaxSigDigits = max
+		})
+	}
+	if max < 0 {
+		max = maxDigits
+	}
+	return sk.add(func(f *Format) {
+		f.MinFracDigits = min
+		f.MaxFracDigits = max
+	})
+}
+
+// maxDigits stands in for an unlimited number of digits.
+const maxDigits = 100
+
+// parseDigits counts required digits, written as '0' or the '@' prefix,
+// followed by optional '#' digits or by '*' for unlimited digits, which is
+// reported as a negative maximum.
+func parseDigits(s string, required byte) (min, max int, ok bool) {
+	if required == '.' {
+		required = '0'
+	}
+	for min < len(s) && s[min] == required {
+		min++
+	}
+	rest := s[min:]
+	switch {
+	case rest == "*" || rest == "+":
+		return min, -1, true
+	case strings.Trim(rest, "#") == "":
+		return min, min + len(rest), true
+	}
+	return 0, 0, false
+}
+
+func (sk *Skeleton) parseStem(stem string) bool {
+	switch stem {
+	case "percent", "%":
+		sk.unit = "%"
+	case "%x100":
+		sk.unit = "%"
+		return sk.add(func(f *Format) { f.Scale = 2 })
+	case "permille":
+		sk.unit = "‰"
+	case "base-unit":
+	case "unit-width-narrow":
+		sk.display = NarrowSymbolDisplay
+	case "unit-width-short":
+		sk.display = SymbolDisplay
+	case "unit-width-iso-code":
+		sk.display = ISOCodeDisplay
+	case "unit-width-full-name":
+		sk.fullName = true
+	case "group-off", ",_":
+		return sk.add(func(f *Format) { f.Grouping = false })
+	case "group-min2", ",?":
+		return sk.add(func(f *Format) { f.MinGrouping = 2 })
+	case "group-auto", "group-on-aligned", ",!":
+		return sk.add(func(f *Format) { f.Grouping = true })
+	case "group-thousands", ",=":
+		return sk.add(func(f *Format) {
+			sym := *f.Symbols
+			sym.PrimaryGroup, sym.SecondaryGroup = 3, 3
+			f.Symbols = &sym
+			f.Grouping = true
+		})
+	case "sign-auto":
+		return sk.add(func(f *Format) { f.Sign = SignAuto })
+	case "sign-always", "+!":
+		return sk.add(func(f *Format) { f.Sign = SignAlways })
+	case "sign-never", "+_":
+		return sk.add(func(f *Format) { f.Sign = SignNever })
+	case "sign-except-zero", "+?":
+		return sk.add(func(f *Format) { f.Sign = SignExceptZero })
+	default:
+		return false
+	}
+	return true
+}
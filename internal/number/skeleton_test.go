@@ -0,0 +1,153 @@
+package number
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestSkeleton(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		skeleton string
+		value    string
+		expected string
+	}{
+		{"en", "", "1234.5678", "1,234.568"},
+		{"en", ".00", "1234.5", "1,234.50"},
+		{"en", ".0#", "3.14159", "3.14"},
+		{"en", ".0#", "3", "3.0"},
+		{"en", ".00*", "1.23456", "1.23456"},
+		{"en", "precision-integer", "2.5", "2"},
+		{"en", "@@@", "12345", "12,300"},
+		{"en", "@@@", "1.2", "1.20"},
+		{"en", "@##", "0.012345", "0.0123"},
+		{"en", "group-off", "1234567", "1234567"},
+		{"en", ",_ .00", "1234567", "1234567.00"},
+		{"en", "group-min2", "1234", "1234"},
+		{"en", "group-min2", "12345", "12,345"},
+		{"hi", "", "1234567", "12,34,567"},
+		{"hi", "group-thousands", "1234567", "1,234,567"},
+		{"en", "integer-width/*000", "7", "007"},
+		{"en", "percent", "25", "25%"},
+		{"en", "%x100", "0.25", "25%"},
+		{"en", "percent scale/100", "0.256", "25.6%"},
+		{"fr", "unit/percent", "25", "25\u00a0%"},
+		{"en", "permille", "2.5", "2.5‰"},
+		{"en", "measure-unit/length-meter", "5", "5 m"},
+		{"en", "unit/meter unit-width-full-name", "1", "1 meter"},
+		{"en", "unit/meter unit-width-full-name", "1.0", "1 meter"},
+		{"en", "unit/meter unit-width-full-name .0", "1", "1.0 meters"},
+		{"en", "unit/kilogram unit-width-full-name", "-2.5", "-2.5 kilograms"},
+		{"en", "unit/celsius", "21", "21°C"},
+		{"en", "unit/gigabyte compact-short", "2000", "2K GB"},
+		{"de", "unit/kilometer unit-width-full-name", "1234.5", "1.234,5 Kilometer"},
+		{"de", "unit/mile unit-width-full-name", "1", "1 Meile"},
+		{"fr", "unit/liter", "1.5", "1,5\u00a0l"},
+		{"fr", "unit/liter unit-width-full-name", "1.5", "1,5 litre"},
+		{"ru", "unit/meter unit-width-full-name", "1", "1 метр"},
+		{"ru", "unit/meter unit-width-full-name", "3", "3 метра"},
+		{"ru", "unit/meter unit-width-full-name", "5", "5 метров"},
+		{"ru", "unit/meter unit-width-full-name", "1.5", "1,5 метра"},
+		{"ja", "unit/kilogram unit-width-full-name", "3", "3 kg"},
+		{"en", "scale/0.5", "9", "4.5"},
+		{"en", "sign-always", "3", "+3"},
+		{"en", "+!", "0", "+0"},
+		{"en", "sign-except-zero", "0", "0"},
+		{"en", "+?", "-2", "-2"},
+		{"en", "sign-never", "-2", "2"},
+		{"en", "scientific", "1234", "1.234E3"},
+		{"en", "scientific .0", "0.00123", "1.2E-3"},
+		{"en", "E00 .0", "98765", "9.9E04"},
+		{"en", "engineering", "12345", "12.345E3"},
+		{"en", "EE0 precision-integer", "999999", "1E6"},
+		{"en", "compact-short", "1234", "1.2K"},
+		{"en", "K", "12345", "12K"},
+		{"en", "compact-short", "999999", "1M"},
+		{"en", "compact-short", "987", "987"},
+		{"en", "compact-long", "2500000", "2.5 million"},
+		{"en", "KK .00", "1234", "1.23 thousand"},
+		{"de", "compact-short", "1234", "1.234"},
+		{"de", "compact-short", "1234567", "1,2\u00a0Mio."},
+		{"de", "compact-long", "1000000", "1 Million"},
+		{"de", "compact-long", "3000000", "3 Millionen"},
+		{"ru", "compact-long", "5000", "5 тысяч"},
+		{"ja", "compact-short", "123456", "12万"},
+		{"xx", "compact-short", "1500", "1.5K"},
+		{"ar", "scientific", "1234", "١٫٢٣٤E٣"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			sk, err := ParseSkeleton(tc.skeleton)
+			require.NoError(err)
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			actual := sk.Format(language.Make(tc.lang), currency.Unit{}).Format(d)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestCurrencySkeleton(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		skeleton string
+		value    string
+		expected string
+	}{
+		{"en", "currency/EUR", "1234.5", "€1,234.50"},
+		{"en", "currency/EUR .00", "3", "€3.00"},
+		{"en", "currency/JPY", "1234.5", "¥1,234"},
+		{"en", "currency/USD precision-integer", "12.5", "$12"},
+		{"en", "currency/USD @@", "1.234", "$1.2"},
+		{"en-CA", "currency/USD unit-width-narrow", "5", "$5.00"},
+		{"en", "currency/USD unit-width-iso-code", "5", "USD\u00a05.00"},
+		{"de", "currency/EUR", "1234.5", "1.234,50\u00a0€"},
+		{"en", "currency/USD compact-short", "1234567", "$1.2M"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			sk, err := ParseSkeleton(tc.skeleton)
+			require.NoError(err)
+			unit, ok := sk.Currency()
+			require.True(ok)
+			d, err := decimal.Parse(tc.value)
+			require.NoError(err)
+			actual := sk.Format(language.Make(tc.lang), unit).Format(d)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestSkeletonErrors(t *testing.T) {
+	for _, skeleton := range []string{
+		"currency/XYZW",
+		"currency/EUR/USD",
+		"measure-unit/mass-meter",
+		"measure-unit/meter",
+		"unit/length-meter",
+		"unit/parsec",
+		"currency/EUR unit/meter",
+		"currency/EUR unit-width-full-name",
+		"integer-width/##0",
+		"scale/-1",
+		"scale/zero",
+		".0#0",
+		"@#@",
+		"E",
+		"precision-increment/0.05",
+		"bogus",
+	} {
+		_, err := ParseSkeleton(skeleton)
+		require.Error(t, err, skeleton)
+	}
+}
@@ -0,0 +1,125 @@
+package number
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	xnumber "golang.org/x/text/number"
+)
+
+// Symbols are the locale specific pieces used to render numbers.
+type Symbols struct {
+	Digits         [10]string
+	Decimal        string
+	Group          string
+	Minus          string
+	PercentPrefix  string
+	PercentSuffix  string
+	PrimaryGroup   int // digits in the group left of the decimal point, 0 for none
+	SecondaryGroup int // digits in each further group
+}
+
+var symbols sync.Map // map[language.Tag]*Symbols
+
+// SymbolsFor returns the number symbols of lang.
+func SymbolsFor(lang language.Tag) *Symbols {
+	if sym, ok := symbols.Load(lang); ok {
+		return sym.(*Symbols)
+	}
+	sym, _ := symbols.LoadOrStore(lang, probeSymbols(lang))
+	return sym.(*Symbols)
+}
+
+// probeSymbols extracts symbols from numbers rendered by x/text, which
+// carries the CLDR data but does not expose it directly.
+func probeSymbols(lang language.Tag) *Symbols {
+	p := message.NewPrinter(lang)
+	sym := &Symbols{}
+	for i := range sym.Digits {
+		sym.Digits[i] = p.Sprint(xnumber.Decimal(i))
+	}
+
+	if runs := sym.split(p.Sprint(xnumber.Decimal(0.5))); len(runs) == 3 {
+		sym.Decimal = runs[1]
+	}
+	if runs := sym.split(p.Sprint(xnumber.Decimal(1234567))); len(runs) >= 3 {
+		sym.Group = runs[len(runs)-2]
+		sym.PrimaryGroup = utf8.RuneCountInString(runs[len(runs)-1])
+		sym.SecondaryGroup = utf8.RuneCountInString(runs[len(runs)-3])
+	}
+	if runs := sym.split(p.Sprint(xnumber.Decimal(-1))); len(runs) == 2 {
+		sym.Minus = runs[0]
+	}
+	if runs := sym.split(p.Sprint(xnumber.Percent(0.5))); len(runs) > 0 {
+		if !sym.isDigits(runs[0]) {
+			sym.PercentPrefix = runs[0]
+		}
+		if last := runs[len(runs)-1]; !sym.isDigits(last) {
+			sym.PercentSuffix = last
+		}
+	}
+	return sym
+}
+
+// split breaks s into alternating runs of digits and other characters.
+func (sym *Symbols) split(s string) []string {
+	var runs []string
+	var b strings.Builder
+	inDigits := false
+	for s != "" {
+		n, isDigit := sym.digitPrefix(s)
+		if n == 0 {
+			_, n = utf8.DecodeRuneInString(s)
+		}
+		if b.Len() > 0 && isDigit != inDigits {
+			runs = append(runs, b.String())
+			b.Reset()
+		}
+		inDigits = isDigit
+		b.WriteString(s[:n])
+		s = s[n:]
+	}
+	if b.Len() > 0 {
+		runs = append(runs, b.String())
+	}
+	return runs
+}
+
+func (sym *Symbols) digitPrefix(s string) (int, bool) {
+	for _, digit := range sym.Digits {
+		if digit != "" && strings.HasPrefix(s, digit) {
+			return len(digit), true
+		}
+	}
+	return 0, false
+}
+
+func (sym *Symbols) isDigits(s string) bool {
+	for s != "" {
+		n, ok := sym.digitPrefix(s)
+		if !ok {
+			return false
+		}
+		s = s[n:]
+	}
+	return true
+}
+
+// Localize replaces the ASCII digits in s with the locale's digits.
+func (sym *Symbols) Localize(s string) string {
+	if sym.Digits[0] == "0" {
+		return s
+	}
+	var b strings.Builder
+	for _, ch := range s {
+		if ch >= '0' && ch <= '9' {
+			b.WriteString(sym.Digits[ch-'0'])
+		} else {
+			b.WriteRune(ch)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,196 @@
+package number
+
+import (
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// unitNames hold the patterns of a measure unit by plural category, with
+// "{0}" standing for the number.
+type unitNames struct {
+	short map[plural.Form]string
+	long  map[plural.Form]string
+}
+
+// measureUnits maps the core IDs of the supported measure units to their
+// CLDR category, as in "length-meter".
+var measureUnits = map[string]string{
+	"meter":      "length",
+	"kilometer":  "length",
+	"centimeter": "length",
+	"mile":       "length",
+	"kilogram":   "mass",
+	"gram":       "mass",
+	"pound":      "mass",
+	"liter":      "volume",
+	"celsius":    "temperature",
+	"fahrenheit": "temperature",
+	"megabyte":   "digital",
+	"gigabyte":   "digital",
+}
+
+// rootUnitNames are the short patterns of the CLDR root locale, used for
+// both widths by languages without names of their own.
+var rootUnitNames = map[string]string{
+	"meter":      "{0} m",
+	"kilometer":  "{0} km",
+	"centimeter": "{0} cm",
+	"mile":       "{0} mi",
+	"kilogram":   "{0} kg",
+	"gram":       "{0} g",
+	"pound":      "{0} lb",
+	"liter":      "{0} L",
+	"celsius":    "{0}°C",
+	"fahrenheit": "{0}°F",
+	"megabyte":   "{0} MB",
+	"gigabyte":   "{0} GB",
+}
+
+// allUnitNames is keyed by base language and then by core unit ID.
+var allUnitNames = map[string]map[string]unitNames{
+	"de": {
+		"meter":      {forms("{0} m"), forms("{0} Meter")},
+		"kilometer":  {forms("{0} km"), forms("{0} Kilometer")},
+		"centimeter": {forms("{0} cm"), forms("{0} Zentimeter")},
+		"mile":       {forms("{0} mi"), forms2("{0} Meile", "{0} Meilen")},
+		"kilogram":   {forms("{0} kg"), forms("{0} Kilogramm")},
+		"gram":       {forms("{0} g"), forms("{0} Gramm")},
+		"pound":      {forms("{0} lb"), forms("{0} Pfund")},
+		"liter":      {forms("{0} l"), forms("{0} Liter")},
+		"celsius":    {forms("{0} °C"), forms("{0} Grad Celsius")},
+		"fahrenheit": {forms("{0} °F"), forms("{0} Grad Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms("{0} Megabyte")},
+		"gigabyte":   {forms("{0} GB"), forms("{0} Gigabyte")},
+	},
+	"en": {
+		"meter":      {forms("{0} m"), forms2("{0} meter", "{0} meters")},
+		"kilometer":  {forms("{0} km"), forms2("{0} kilometer", "{0} kilometers")},
+		"centimeter": {forms("{0} cm"), forms2("{0} centimeter", "{0} centimeters")},
+		"mile":       {forms("{0} mi"), forms2("{0} mile", "{0} miles")},
+		"kilogram":   {forms("{0} kg"), forms2("{0} kilogram", "{0} kilograms")},
+		"gram":       {forms("{0} g"), forms2("{0} gram", "{0} grams")},
+		"pound":      {forms("{0} lb"), forms2("{0} pound", "{0} pounds")},
+		"liter":      {forms("{0} L"), forms2("{0} liter", "{0} liters")},
+		"celsius":    {forms("{0}°C"), forms2("{0} degree Celsius", "{0} degrees Celsius")},
+		"fahrenheit": {forms("{0}°F"), forms2("{0} degree Fahrenheit", "{0} degrees Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms2("{0} megabyte", "{0} megabytes")},
+		"gigabyte":   {forms("{0} GB"), forms2("{0} gigabyte", "{0} gigabytes")},
+	},
+	"es": {
+		"meter":      {forms("{0} m"), forms2("{0} metro", "{0} metros")},
+		"kilometer":  {forms("{0} km"), forms2("{0} kilómetro", "{0} kilómetros")},
+		"centimeter": {forms("{0} cm"), forms2("{0} centímetro", "{0} centímetros")},
+		"mile":       {forms("{0} mi"), forms2("{0} milla", "{0} millas")},
+		"kilogram":   {forms("{0} kg"), forms2("{0} kilogramo", "{0} kilogramos")},
+		"gram":       {forms("{0} g"), forms2("{0} gramo", "{0} gramos")},
+		"pound":      {forms("{0} lb"), forms2("{0} libra", "{0} libras")},
+		"liter":      {forms("{0} l"), forms2("{0} litro", "{0} litros")},
+		"celsius":    {forms("{0} °C"), forms2("{0} grado Celsius", "{0} grados Celsius")},
+		"fahrenheit": {forms("{0} °F"), forms2("{0} grado Fahrenheit", "{0} grados Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms2("{0} megabyte", "{0} megabytes")},
+		"gigabyte":   {forms("{0} GB"), forms2("{0} gigabyte", "{0} gigabytes")},
+	},
+	"fr": {
+		"meter":      {forms("{0}\u00a0m"), forms2("{0} mètre", "{0} mètres")},
+		"kilometer":  {forms("{0}\u00a0km"), forms2("{0} kilomètre", "{0} kilomètres")},
+		"centimeter": {forms("{0}\u00a0cm"), forms2("{0} centimètre", "{0} centimètres")},
+		"mile":       {forms("{0}\u00a0mi"), forms2("{0} mile", "{0} miles")},
+		"kilogram":   {forms("{0}\u00a0kg"), forms2("{0} kilogramme", "{0} kilogrammes")},
+		"gram":       {forms("{0}\u00a0g"), forms2("{0} gramme", "{0} grammes")},
+		"pound":      {forms("{0}\u00a0lb"), forms2("{0} livre", "{0} livres")},
+		"liter":      {forms("{0}\u00a0l"), forms2("{0} litre", "{0} litres")},
+		"celsius":    {forms("{0}\u00a0°C"), forms2("{0} degré Celsius", "{0} degrés Celsius")},
+		"fahrenheit": {forms("{0}\u00a0°F"), forms2("{0} degré Fahrenheit", "{0} degrés Fahrenheit")},
+		"megabyte":   {forms("{0}\u00a0Mo"), forms2("{0} mégaoctet", "{0} mégaoctets")},
+		"gigabyte":   {forms("{0}\u00a0Go"), forms2("{0} gigaoctet", "{0} gigaoctets")},
+	},
+	"it": {
+		"meter":      {forms("{0} m"), forms2("{0} metro", "{0} metri")},
+		"kilometer":  {forms("{0} km"), forms2("{0} chilometro", "{0} chilometri")},
+		"centimeter": {forms("{0} cm"), forms2("{0} centimetro", "{0} centimetri")},
+		"mile":       {forms("{0} mi"), forms2("{0} miglio", "{0} miglia")},
+		"kilogram":   {forms("{0} kg"), forms2("{0} chilogrammo", "{0} chilogrammi")},
+		"gram":       {forms("{0} g"), forms2("{0} grammo", "{0} grammi")},
+		"pound":      {forms("{0} lb"), forms2("{0} libbra", "{0} libbre")},
+		"liter":      {forms("{0} l"), forms2("{0} litro", "{0} litri")},
+		"celsius":    {forms("{0} °C"), forms2("{0} grado Celsius", "{0} gradi Celsius")},
+		"fahrenheit": {forms("{0} °F"), forms2("{0} grado Fahrenheit", "{0} gradi Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms("{0} megabyte")},
+		"gigabyte":   {forms("{0} GB"), forms("{0} gigabyte")},
+	},
+	"nl": {
+		"meter":      {forms("{0} m"), forms("{0} meter")},
+		"kilometer":  {forms("{0} km"), forms("{0} kilometer")},
+		"centimeter": {forms("{0} cm"), forms("{0} centimeter")},
+		"mile":       {forms("{0} mi"), forms("{0} mijl")},
+		"kilogram":   {forms("{0} kg"), forms("{0} kilogram")},
+		"gram":       {forms("{0} g"), forms("{0} gram")},
+		"pound":      {forms("{0} lb"), forms("{0} pond")},
+		"liter":      {forms("{0} l"), forms("{0} liter")},
+		"celsius":    {forms("{0} °C"), forms2("{0} graad Celsius", "{0} graden Celsius")},
+		"fahrenheit": {forms("{0} °F"), forms2("{0} graad Fahrenheit", "{0} graden Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms("{0} megabyte")},
+		"gigabyte":   {forms("{0} GB"), forms("{0} gigabyte")},
+	},
+	"pt": {
+		"meter":      {forms("{0} m"), forms2("{0} metro", "{0} metros")},
+		"kilometer":  {forms("{0} km"), forms2("{0} quilômetro", "{0} quilômetros")},
+		"centimeter": {forms("{0} cm"), forms2("{0} centímetro", "{0} centímetros")},
+		"mile":       {forms("{0} mi"), forms2("{0} milha", "{0} milhas")},
+		"kilogram":   {forms("{0} kg"), forms2("{0} quilograma", "{0} quilogramas")},
+		"gram":       {forms("{0} g"), forms2("{0} grama", "{0} gramas")},
+		"pound":      {forms("{0} lb"), forms2("{0} libra", "{0} libras")},
+		"liter":      {forms("{0} l"), forms2("{0} litro", "{0} litros")},
+		"celsius":    {forms("{0} °C"), forms2("{0} grau Celsius", "{0} graus Celsius")},
+		"fahrenheit": {forms("{0} °F"), forms2("{0} grau Fahrenheit", "{0} graus Fahrenheit")},
+		"megabyte":   {forms("{0} MB"), forms2("{0} megabyte", "{0} megabytes")},
+		"gigabyte":   {forms("{0} GB"), forms2("{0} gigabyte", "{0} gigabytes")},
+	},
+	"ru": {
+		"meter":      {forms("{0} м"), forms4("{0} метр", "{0} метра", "{0} метров", "{0} метра")},
+		"kilometer":  {forms("{0} км"), forms4("{0} километр", "{0} километра", "{0} километров", "{0} километра")},
+		"centimeter": {forms("{0} см"), forms4("{0} сантиметр", "{0} сантиметра", "{0} сантиметров", "{0} сантиметра")},
+		"mile":       {forms("{0} ми"), forms4("{0} миля", "{0} мили", "{0} миль", "{0} мили")},
+		"kilogram":   {forms("{0} кг"), forms4("{0} килограмм", "{0} килограмма", "{0} килограммов", "{0} килограмма")},
+		"gram":       {forms("{0} г"), forms4("{0} грамм", "{0} грамма", "{0} граммов", "{0} грамма")},
+		"pound":      {forms("{0} фнт"), forms4("{0} фунт", "{0} фунта", "{0} фунтов", "{0} фунта")},
+		"liter":      {forms("{0} л"), forms4("{0} литр", "{0} литра", "{0} литров", "{0} литра")},
+		"celsius":    {forms("{0} °C"), forms4("{0} градус Цельсия", "{0} градуса Цельсия", "{0} градусов Цельсия", "{0} градуса Цельсия")},
+		"fahrenheit": {forms("{0} °F"), forms4("{0} градус Фаренгейта", "{0} градуса Фаренгейта", "{0} градусов Фаренгейта", "{0} градуса Фаренгейта")},
+		"megabyte":   {forms("{0} МБ"), forms4("{0} мегабайт", "{0} мегабайта", "{0} мегабайт", "{0} мегабайта")},
+		"gigabyte":   {forms("{0} ГБ"), forms4("{0} гигабайт", "{0} гигабайта", "{0} гигабайт", "{0} гигабайта")},
+	},
+}
+
+// parseMeasureUnit accepts a core unit ID such as "meter", or one with its
+// category such as "length-meter" when qualified is set.
+func parseMeasureUnit(option string, qualified bool) (string, bool) {
+	unit := option
+	if qualified {
+		i := strings.IndexByte(option, '-')
+		if i < 0 {
+			return "", false
+		}
+		unit = option[i+1:]
+		if measureUnits[unit] != option[:i] {
+			return "", false
+		}
+	}
+	_, ok := measureUnits[unit]
+	return unit, ok
+}
+
+// unitForms returns the short or long patterns of unit in lang.
+func unitForms(lang language.Tag, unit string, long bool) map[plural.Form]string {
+	base, _ := lang.Base()
+	names, ok := allUnitNames[base.String()][unit]
+	switch {
+	case !ok:
+		return forms(rootUnitNames[unit])
+	case long:
+		return names.long
+	}
+	return names.short
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -10,9 +11,35 @@ import (
 	"github.com/sjansen/messageformat/internal/decoder"
 )
 
+var (
+	argTypeKeywords  = []string{"plural", "select", "selectordinal"}
+	argStyleKeywords = []string{}
+	pluralKeys       = []string{"zero", "one", "two", "few", "many", "other"}
+)
+
+func init() {
+	for t := ast.DefaultType + 1; t < ast.InvalidType; t++ {
+		argTypeKeywords = append(argTypeKeywords, t.ToKeyword())
+	}
+	sort.Strings(argTypeKeywords)
+	for s := ast.DefaultStyle + 1; s < ast.InvalidStyle; s++ {
+		if keyword := ast.ArgStyle(s).ToKeyword(); keyword != "" {
+			argStyleKeywords = append(argStyleKeywords, keyword)
+		}
+	}
+}
+
+type diagnostics struct {
+	errors []error
+}
+
+func (d *diagnostics) add(err error) {
+	d.errors = append(d.errors, err)
+}
+
 func Parse(s string) (*ast.Message, error) {
 	dec := decoder.New(s)
-	parts, err := parseMessage(dec, 0, false)
+	parts, err := parseMessage(dec, 0, false, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -20,60 +47,120 @@ func Parse(s string) (*ast.Message, error) {
 	return msg, nil
 }
 
-func parseArgument(dec *decoder.Decoder, depth int) (ast.Part, error) {
+// ParseAll is like Parse but, instead of stopping at the first error, it
+// skips past arguments and branches it cannot parse, replacing them with
+// *ast.BadArg, and reports every error found.
+func ParseAll(s string) (*ast.Message, []error) {
+	dec := decoder.New(s)
+	diag := &diagnostics{}
+	parts, err := parseMessage(dec, 0, false, diag)
+	if err != nil {
+		diag.add(err)
+	}
+	msg := &ast.Message{Parts: parts}
+	return msg, diag.errors
+}
+
+func parseArgument(dec *decoder.Decoder, depth int, diag *diagnostics) (ast.Part, error) {
+	begin := dec.Position()
 	if err := requireRune(dec, '{'); err != nil {
 		return nil, err
 	}
 
+	arg, err := parseArgumentBody(dec, depth, begin, diag)
+	if e, ok := err.(*errors.UnexpectedEOF); ok && expects(e.Expected, "}") {
+		err = &errors.UnterminatedArgument{
+			Pos:      e.Pos,
+			Begin:    begin,
+			Expected: e.Expected,
+		}
+	}
+	return arg, err
+}
+
+func parseArgumentBody(dec *decoder.Decoder, depth int, begin ast.Position, diag *diagnostics) (ast.Part, error) {
 	skipWhiteSpace(dec)
-	argNameOrNumber := parseID(dec)
+	idBegin := dec.Position()
+	argNameOrNumber, err := requireID(dec, "argument name or number")
+	if err != nil {
+		return nil, err
+	}
+	if ch := argNameOrNumber[0]; ch >= '0' && ch <= '9' {
+		if _, ok := ast.ArgNumber(argNameOrNumber); !ok {
+			return nil, &errors.InvalidArgID{
+				Pos:      idBegin,
+				ID:       argNameOrNumber,
+				Expected: []string{"argument name or number"},
+			}
+		}
+	}
 	skipWhiteSpace(dec)
 
-	dec.Decode()
-	ch := dec.Decoded()
-	switch ch {
+	switch dec.Peek() {
 	case '}':
-		arg := &ast.PlainArg{ArgID: argNameOrNumber}
+		dec.Decode()
+		arg := &ast.PlainArg{
+			Positions: newPositions(begin, dec),
+			ArgID:     argNameOrNumber,
+		}
 		return arg, nil
 	case ',':
+		dec.Decode()
 		skipWhiteSpace(dec)
 	default:
-		return nil, &errors.UnexpectedToken{Token: string(ch)}
+		return nil, unexpected(dec, "}", ",")
+	}
+
+	keywordBegin := dec.Position()
+	keyword, err := requireID(dec, "argument type")
+	if err != nil {
+		return nil, err
 	}
 
 	var arg ast.Part
-	if keyword := parseID(dec); keyword == "select" {
-		messages, err := parseSelectStyle(dec, depth)
-		if err != nil {
+	if keyword == "select" {
+		tmp := &ast.SelectArg{ArgID: argNameOrNumber}
+		if err := parseSelectStyle(dec, depth, tmp, diag); err != nil {
 			return nil, err
 		}
-		arg = &ast.SelectArg{ArgID: argNameOrNumber, Messages: messages}
+		arg = tmp
 	} else if keyword == "plural" || keyword == "selectordinal" {
-		messages, err := parsePluralStyle(dec, depth)
-		if err != nil {
-			return nil, err
-		}
-		arg = &ast.PluralArg{
+		tmp := &ast.PluralArg{
 			ArgID:   argNameOrNumber,
 			Ordinal: keyword == "selectordinal",
-			// TODO Offset
-			Messages: messages,
 		}
+		if err := parsePluralStyle(dec, depth, tmp, diag); err != nil {
+			return nil, err
+		}
+		arg = tmp
 	} else if argType := ast.ArgTypeFromKeyword(keyword); argType != ast.InvalidType {
-		// TODO argStyleText and argSkeletonText
-		argStyle, err := parseSimpleStyle(dec, depth)
-		if err != nil {
+		tmp := &ast.SimpleArg{ArgID: argNameOrNumber, ArgType: argType}
+		if err := parseSimpleStyle(dec, depth, tmp); err != nil {
 			return nil, err
 		}
-		arg = &ast.SimpleArg{ArgID: argNameOrNumber, ArgType: argType, ArgStyle: argStyle}
+		arg = tmp
 	} else {
-		return nil, &errors.UnexpectedToken{Token: keyword}
+		return nil, &errors.InvalidArgType{
+			Pos:      keywordBegin,
+			Keyword:  keyword,
+			Expected: argTypeKeywords,
+		}
 	}
 
 	if err := requireRune(dec, '}'); err != nil {
 		return nil, err
 	}
 
+	positions := newPositions(begin, dec)
+	switch x := arg.(type) {
+	case *ast.PluralArg:
+		x.Positions = positions
+	case *ast.SelectArg:
+		x.Positions = positions
+	case *ast.SimpleArg:
+		x.Positions = positions
+	}
+
 	return arg, nil
 }
 
@@ -82,15 +169,14 @@ func parseID(dec *decoder.Decoder) string {
 	for dec.Decode() {
 		ch := dec.Decoded()
 		b.WriteRune(ch)
-		next := dec.Peek()
-		if unicode.In(next, unicode.Pattern_White_Space, unicode.Pattern_Syntax) {
+		if isSyntaxOrSpace(dec.Peek()) {
 			break
 		}
 	}
 	return b.String()
 }
 
-func parseMessage(dec *decoder.Decoder, depth int, inPlural bool) ([]ast.Part, error) {
+func parseMessage(dec *decoder.Decoder, depth int, inPlural bool, diag *diagnostics) ([]ast.Part, error) {
 	parts := []ast.Part{}
 	if depth > 0 {
 		if err := requireRune(dec, '{'); err != nil {
@@ -101,19 +187,32 @@ loop:
 	for {
 		next := dec.Peek()
 		switch {
-		case next == utf8.RuneError:
-			break loop // TODO
+		case dec.EOF():
+			break loop
 		case depth > 0 && next == '}':
 			break loop
 		case next == '{':
-			part, err := parseArgument(dec, depth)
+			begin, offset := dec.Position(), dec.Offset()
+			part, err := parseArgument(dec, depth, diag)
 			if err != nil {
-				return nil, err
+				if diag == nil {
+					return nil, err
+				}
+				diag.add(err)
+				skipArgument(dec)
+				part = &ast.BadArg{
+					Positions: newPositions(begin, dec),
+					Source:    dec.Slice(offset, dec.Offset()),
+					Err:       err,
+				}
 			}
 			parts = append(parts, part)
 		case inPlural && next == '#':
+			begin := dec.Position()
 			dec.Decode()
-			parts = append(parts, &ast.NumberSign{})
+			parts = append(parts, &ast.NumberSign{
+				Positions: newPositions(begin, dec),
+			})
 		default:
 			part, err := parseMessageText(dec, depth, inPlural)
 			if err != nil {
@@ -131,6 +230,7 @@ loop:
 }
 
 func parseMessageText(dec *decoder.Decoder, depth int, inPlural bool) (*ast.Text, error) {
+	begin := dec.Position()
 	b := &strings.Builder{}
 	for dec.Decode() {
 		ch := dec.Decoded()
@@ -147,7 +247,10 @@ func parseMessageText(dec *decoder.Decoder, depth int, inPlural bool) (*ast.Text
 			}
 		}
 	}
-	t := &ast.Text{Value: b.String()}
+	t := &ast.Text{
+		Positions: newPositions(begin, dec),
+		Value:     b.String(),
+	}
 	return t, nil
 }
 
@@ -165,6 +268,8 @@ func parseMessageTextAfterQuote(b *strings.Builder, dec *decoder.Decoder, depth
 		done = (next == '{') || (depth > 0 && next == '}') || (inPlural && next == '#')
 	case next == '{' || next == '}' || (inPlural && next == '#'):
 		parseMessageTextInQuote(b, dec)
+		next := dec.Peek()
+		done = (next == '{') || (depth > 0 && next == '}') || (inPlural && next == '#')
 	default:
 		b.WriteRune('\'')
 	}
@@ -190,19 +295,51 @@ func parseMessageTextInQuote(b *strings.Builder, dec *decoder.Decoder) {
 	}
 }
 
-func parsePluralStyle(dec *decoder.Decoder, depth int) (map[string]*ast.Message, error) {
+func parsePluralOffset(dec *decoder.Decoder) (int, error) {
+	if err := requireRune(dec, ':'); err != nil {
+		return 0, err
+	}
+	skipWhiteSpace(dec)
+	if next := dec.Peek(); dec.EOF() || next < '0' || next > '9' {
+		return 0, unexpected(dec, "digit")
+	}
+	begin, start := dec.Position(), dec.Offset()
+	offset, overflow := 0, false
+	for next := dec.Peek(); next >= '0' && next <= '9'; next = dec.Peek() {
+		dec.Decode()
+		if offset > (maxPluralOffset-int(next-'0'))/10 {
+			overflow = true
+		}
+		offset = offset*10 + int(next-'0')
+	}
+	if overflow {
+		return 0, &errors.InvalidPluralOffset{
+			Pos:      begin,
+			Offset:   dec.Slice(start, dec.Offset()),
+			Expected: []string{"offset up to 2147483647"},
+		}
+	}
+	return offset, nil
+}
+
+// maxPluralOffset matches the largest argument number, 2^31-1.
+const maxPluralOffset = 1<<31 - 1
+
+func parsePluralStyle(dec *decoder.Decoder, depth int, arg *ast.PluralArg, diag *diagnostics) error {
 	skipWhiteSpace(dec)
 	if err := requireRune(dec, ','); err != nil {
-		return nil, err
+		return err
 	}
 
-	messages := map[string]*ast.Message{}
-	for {
+	arg.Messages = map[string]*ast.Message{}
+	arg.KeyPositions = map[string]*ast.Positions{}
+	for first := true; ; first = false {
 		skipWhiteSpace(dec)
 		next := dec.Peek()
 		if next == '}' {
-			return messages, nil
+			return nil
 		}
+		begin := dec.Position()
 		var id string
 		if next == '=' {
 			var b strings.Builder
@@ -213,76 +350,283 @@ func parsePluralStyle(dec *decoder.Decoder, depth int) (map[string]*ast.Message,
 					break
 				}
 			}
+			// Keep the rest of a malformed key such as "=x" so that it is
+			// reported whole and its branch can be skipped.
+			valid := b.Len() > 1
+			if next := dec.Peek(); !dec.EOF() && !isSyntaxOrSpace(next) {
+				b.WriteString(parseID(dec))
+				valid = false
+			}
 			id = b.String()
+			if !valid {
+				err := &errors.InvalidPluralKey{
+					Pos:      begin,
+					Key:      id,
+					Expected: pluralKeys,
+				}
+				if err := skipBranch(dec, depth, diag, err); err != nil {
+					return err
+				}
+				continue
+			}
 		} else {
-			id = parseID(dec)
+			var err error
+			if id, err = requireID(dec, "plural key", "}"); err != nil {
+				if err := skipBranch(dec, depth, diag, err); err != nil {
+					return err
+				}
+				continue
+			}
+			if first && id == "offset" && dec.Peek() == ':' {
+				offset, err := parsePluralOffset(dec)
+				if err != nil {
+					return err
+				}
+				arg.Offset = offset
+				continue
+			}
+			if !expects(pluralKeys, id) {
+				err := &errors.InvalidPluralKey{
+					Pos:      begin,
+					Key:      id,
+					Expected: pluralKeys,
+				}
+				if err := skipBranch(dec, depth, diag, err); err != nil {
+					return err
+				}
+				continue
+			}
 		}
+		arg.KeyPositions[id] = newPositions(begin, dec)
 		skipWhiteSpace(dec)
 
-		parts, err := parseMessage(dec, depth+1, true)
+		parts, err := parseMessage(dec, depth+1, true, diag)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		msg := &ast.Message{Parts: parts}
-		messages[id] = msg
+		arg.Messages[id] = msg
 	}
 }
 
-func parseSelectStyle(dec *decoder.Decoder, depth int) (map[string]*ast.Message, error) {
+func parseSelectStyle(dec *decoder.Decoder, depth int, arg *ast.SelectArg, diag *diagnostics) error {
 	skipWhiteSpace(dec)
 	if err := requireRune(dec, ','); err != nil {
-		return nil, err
+		return err
 	}
 
-	messages := map[string]*ast.Message{}
+	arg.Messages = map[string]*ast.Message{}
+	arg.KeyPositions = map[string]*ast.Positions{}
 	for {
 		skipWhiteSpace(dec)
 		next := dec.Peek()
 		if next == '}' {
-			return messages, nil
+			return nil
 		}
-		id := parseID(dec)
+		begin := dec.Position()
+		id, err := requireID(dec, "select key", "}")
+		if err != nil {
+			if err := skipBranch(dec, depth, diag, err); err != nil {
+				return err
+			}
+			continue
+		}
+		arg.KeyPositions[id] = newPositions(begin, dec)
 		skipWhiteSpace(dec)
 
-		parts, err := parseMessage(dec, depth+1, false)
+		parts, err := parseMessage(dec, depth+1, false, diag)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		msg := &ast.Message{Parts: parts}
-		messages[id] = msg
+		arg.Messages[id] = msg
 	}
 }
 
-func parseSimpleStyle(dec *decoder.Decoder, depth int) (ast.ArgStyle, error) {
+func parseSimpleStyle(dec *decoder.Decoder, depth int, arg *ast.SimpleArg) error {
 	skipWhiteSpace(dec)
-	next := dec.Peek()
-	switch next {
+	switch dec.Peek() {
 	case '}':
-		return ast.DefaultStyle, nil
+		return nil
 	case ',':
 		dec.Decode()
 	default:
-		return ast.DefaultStyle, &errors.UnexpectedToken{Token: string(next)}
+		return unexpected(dec, "}", ",")
 	}
 
 	skipWhiteSpace(dec)
-	keyword := parseID(dec)
-	argStyle := ast.ArgStyleFromKeyword(keyword)
-	if argStyle == ast.InvalidStyle {
-		return 0, &errors.UnexpectedToken{Token: keyword}
+	switch dec.Peek() {
+	case ':':
+		skeleton, err := parseSkeleton(dec)
+		if err != nil {
+			return err
+		}
+		arg.ArgStyle = ast.SkeletonStyle
+		arg.Skeleton = skeleton
+		return nil
 	}
+	if dec.EOF() || dec.Peek() == '}' {
+		return unexpected(dec, "argument style")
+	}
+
+	begin := dec.Position()
+	text := parseStyleText(dec)
+	arg.ArgStyle = ast.ArgStyleFromKeyword(text)
+	if arg.ArgStyle != ast.InvalidStyle {
+		return nil
+	}
+	// Date and time patterns may be a single word, such as "yyyy", but
+	// number patterns always include digits or symbols.
+	if isWord(text) && arg.ArgType != ast.DateType && arg.ArgType != ast.TimeType {
+		return &errors.InvalidArgStyle{
+			Pos:      begin,
+			Keyword:  text,
+			Expected: argStyleKeywords,
+		}
+	}
+	arg.ArgStyle = ast.TextStyle
+	arg.StyleText = text
+	return nil
+}
+
+// parseSkeleton consumes "::" and the skeleton that follows it, up to the
+// closing brace of the argument.
+func parseSkeleton(dec *decoder.Decoder) (string, error) {
+	begin := dec.Position()
+	for i := 0; i < 2; i++ {
+		if err := requireRune(dec, ':'); err != nil {
+			return "", err
+		}
+	}
+	var b strings.Builder
+	for !dec.EOF() && dec.Peek() != '}' && dec.Peek() != '{' {
+		dec.Decode()
+		b.WriteRune(dec.Decoded())
+	}
+	if !dec.EOF() && dec.Peek() == '{' {
+		// Skeletons never contain braces, so report the whole style
+		// rather than a missing "}".
+		b.WriteString(parseStyleText(dec))
+		return "", &errors.InvalidArgStyle{
+			Pos:      begin,
+			Keyword:  "::" + strings.TrimSpace(b.String()),
+			Expected: []string{"skeleton"},
+		}
+	}
+	skeleton := strings.TrimSpace(b.String())
+	if skeleton == "" {
+		return "", unexpected(dec, "skeleton")
+	}
+	return skeleton, nil
+}
 
+// parseStyleText consumes a custom style such as "#,##0.00", "yyyy-MM-dd"
+// or "%spellout-ordinal", up to the closing brace of the argument. The
+// text is kept as written, including quotes, and may contain balanced
+// braces. Surrounding white space is trimmed.
+func parseStyleText(dec *decoder.Decoder) string {
+	var b strings.Builder
+	level, quoted := 0, false
+	for !dec.EOF() {
+		switch next := dec.Peek(); {
+		case next == '\'':
+			quoted = !quoted
+		case quoted:
+		case next == '{':
+			level++
+		case next == '}' && level == 0:
+			return strings.TrimSpace(b.String())
+		case next == '}':
+			level--
+		}
+		dec.Decode()
+		b.WriteRune(dec.Decoded())
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func isWord(s string) bool {
+	for _, ch := range s {
+		if !unicode.IsLetter(ch) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// skipArgument advances past the closing brace of an argument whose
+// opening brace has already been consumed.
+func skipArgument(dec *decoder.Decoder) {
+	for level := 1; level > 0 && dec.Decode(); {
+		switch dec.Decoded() {
+		case '{':
+			level++
+		case '}':
+			level--
+		}
+	}
+}
+
+// skipBranch records err and parses, then discards, the message of a
+// branch with an invalid key. If no message follows the key, err is
+// returned so the whole argument is skipped instead.
+func skipBranch(dec *decoder.Decoder, depth int, diag *diagnostics, err error) error {
 	skipWhiteSpace(dec)
-	return argStyle, nil
+	if diag == nil || dec.Peek() != '{' {
+		return err
+	}
+	diag.add(err)
+	_, err = parseMessage(dec, depth+1, false, diag)
+	return err
+}
+
+func expects(expected []string, token string) bool {
+	for _, x := range expected {
+		if x == token {
+			return true
+		}
+	}
+	return false
+}
+
+func newPositions(begin ast.Position, dec *decoder.Decoder) *ast.Positions {
+	return &ast.Positions{
+		Begin: begin,
+		End:   dec.Position(),
+	}
+}
+
+func requireID(dec *decoder.Decoder, expected ...string) (string, error) {
+	if next := dec.Peek(); dec.EOF() || isSyntaxOrSpace(next) {
+		return "", unexpected(dec, expected...)
+	}
+	return parseID(dec), nil
 }
 
 func requireRune(dec *decoder.Decoder, token rune) error {
+	if dec.EOF() || dec.Peek() != token {
+		return unexpected(dec, string(token))
+	}
 	dec.Decode()
-	ch := dec.Decoded()
-	if ch == token {
-		return nil
+	return nil
+}
+
+func unexpected(dec *decoder.Decoder, expected ...string) error {
+	if dec.EOF() {
+		return &errors.UnexpectedEOF{
+			Pos:      dec.Position(),
+			Expected: expected,
+		}
+	}
+	return &errors.UnexpectedToken{
+		Pos:      dec.Position(),
+		Token:    string(dec.Peek()),
+		Expected: expected,
 	}
-	return &errors.UnexpectedToken{Token: string(ch)}
+}
+
+func isSyntaxOrSpace(ch rune) bool {
+	return unicode.In(ch, unicode.Pattern_White_Space, unicode.Pattern_Syntax)
 }
 
 func skipWhiteSpace(dec *decoder.Decoder) {
@@ -7,9 +7,51 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/errors"
 	"github.com/sjansen/messageformat/internal/decoder"
 )
 
+func clearPositions(parts []ast.Part) {
+	for _, part := range parts {
+		switch x := part.(type) {
+		case *ast.BadArg:
+			x.Positions = nil
+		case *ast.NumberSign:
+			x.Positions = nil
+		case *ast.PlainArg:
+			x.Positions = nil
+		case *ast.PluralArg:
+			x.Positions = nil
+			x.KeyPositions = nil
+			for _, msg := range x.Messages {
+				clearPositions(msg.Parts)
+			}
+		case *ast.SelectArg:
+			x.Positions = nil
+			x.KeyPositions = nil
+			for _, msg := range x.Messages {
+				clearPositions(msg.Parts)
+			}
+		case *ast.SimpleArg:
+			x.Positions = nil
+		case *ast.Text:
+			x.Positions = nil
+		}
+	}
+}
+
+func pos(line, byteColumn, runeColumn int) ast.Position {
+	return ast.Position{
+		Line:       line,
+		ByteColumn: byteColumn,
+		RuneColumn: runeColumn,
+	}
+}
+
+func span(begin, end ast.Position) *ast.Positions {
+	return &ast.Positions{Begin: begin, End: end}
+}
+
 func TestParse(t *testing.T) {
 	for idx, tc := range []struct {
 		pattern  string
@@ -101,11 +143,72 @@ func TestParse(t *testing.T) {
 
 			msg, err := Parse(tc.pattern)
 			require.NoError(err)
+			clearPositions(msg.Parts)
 			require.Equal(tc.expected, msg)
 		})
 	}
 }
 
+func TestParsePositions(t *testing.T) {
+	require := require.New(t)
+
+	msg, err := Parse("Olá {name}!\n{n, plural, one{# cão} other{{n, number} cães}}")
+	require.NoError(err)
+	require.Equal(&ast.Message{Parts: []ast.Part{
+		&ast.Text{
+			Positions: span(pos(1, 1, 1), pos(1, 6, 5)),
+			Value:     "Olá ",
+		},
+		&ast.PlainArg{
+			Positions: span(pos(1, 6, 5), pos(1, 12, 11)),
+			ArgID:     "name",
+		},
+		&ast.Text{
+			Positions: span(pos(1, 12, 11), pos(2, 1, 1)),
+			Value:     "!\n",
+		},
+		&ast.PluralArg{
+			Positions: span(pos(2, 1, 1), pos(2, 50, 48)),
+			ArgID:     "n",
+			Messages: map[string]*ast.Message{
+				"one": {Parts: []ast.Part{
+					&ast.NumberSign{
+						Positions: span(pos(2, 17, 17), pos(2, 18, 18)),
+					},
+					&ast.Text{
+						Positions: span(pos(2, 18, 18), pos(2, 23, 22)),
+						Value:     " cão",
+					},
+				}},
+				"other": {Parts: []ast.Part{
+					&ast.SimpleArg{
+						Positions: span(pos(2, 31, 30), pos(2, 42, 41)),
+						ArgID:     "n",
+						ArgType:   ast.NumberType,
+					},
+					&ast.Text{
+						Positions: span(pos(2, 42, 41), pos(2, 48, 46)),
+						Value:     " cães",
+					},
+				}},
+			},
+			KeyPositions: map[string]*ast.Positions{
+				"one":   span(pos(2, 13, 13), pos(2, 16, 16)),
+				"other": span(pos(2, 25, 24), pos(2, 30, 29)),
+			},
+		},
+	}}, msg)
+
+	msg, err = Parse("{x, select, a{A} other{B}}")
+	require.NoError(err)
+	x := msg.Parts[0].(*ast.SelectArg)
+	require.True(x.HasPositions())
+	require.Equal(pos(1, 1, 1), x.Begin())
+	require.Equal(pos(1, 27, 27), x.End())
+	require.Equal(span(pos(1, 13, 13), pos(1, 14, 14)), x.KeyPositions["a"])
+	require.Equal(span(pos(1, 18, 18), pos(1, 23, 23)), x.KeyPositions["other"])
+}
+
 func TestParseArgument(t *testing.T) {
 	for idx, tc := range []struct {
 		pattern  string
@@ -125,6 +228,41 @@ func TestParseArgument(t *testing.T) {
 			ArgID:    "5",
 			ArgType:  ast.NumberType,
 			ArgStyle: ast.PercentStyle}},
+		{"{ 5, spellout, %spellout-ordinal }", &ast.SimpleArg{
+			ArgID:     "5",
+			ArgType:   ast.SpelloutType,
+			ArgStyle:  ast.TextStyle,
+			StyleText: "%spellout-ordinal"}},
+		{"{ n, number, ::currency/EUR .00 }", &ast.SimpleArg{
+			ArgID:    "n",
+			ArgType:  ast.NumberType,
+			ArgStyle: ast.SkeletonStyle,
+			Skeleton: "currency/EUR .00"}},
+		{"{d,date,::yMMMd}", &ast.SimpleArg{
+			ArgID:    "d",
+			ArgType:  ast.DateType,
+			ArgStyle: ast.SkeletonStyle,
+			Skeleton: "yMMMd"}},
+		{"{ n, number, #,##0.00 }", &ast.SimpleArg{
+			ArgID:     "n",
+			ArgType:   ast.NumberType,
+			ArgStyle:  ast.TextStyle,
+			StyleText: "#,##0.00"}},
+		{"{n,number,'{'#'}' ¤}", &ast.SimpleArg{
+			ArgID:     "n",
+			ArgType:   ast.NumberType,
+			ArgStyle:  ast.TextStyle,
+			StyleText: "'{'#'}' ¤"}},
+		{"{d, date, EEE, MMM d 'at' h:mm {a}}", &ast.SimpleArg{
+			ArgID:     "d",
+			ArgType:   ast.DateType,
+			ArgStyle:  ast.TextStyle,
+			StyleText: "EEE, MMM d 'at' h:mm {a}"}},
+		{"{d, date, yyyy}", &ast.SimpleArg{
+			ArgID:     "d",
+			ArgType:   ast.DateType,
+			ArgStyle:  ast.TextStyle,
+			StyleText: "yyyy"}},
 		{"{6,select,afternoon{Boa tarde!}evening{Boa noite!}other{Bom dia!}}", &ast.SelectArg{
 			ArgID: "6",
 			Messages: map[string]*ast.Message{
@@ -164,6 +302,33 @@ func TestParseArgument(t *testing.T) {
 					&ast.Text{Value: "th item"},
 				}},
 			}}},
+		{"{8,plural,offset:1 =0{nobody}=1{just you}one{you and # other}other{you and # others}}", &ast.PluralArg{
+			ArgID:  "8",
+			Offset: 1,
+			Messages: map[string]*ast.Message{
+				"=0": {Parts: []ast.Part{&ast.Text{Value: "nobody"}}},
+				"=1": {Parts: []ast.Part{&ast.Text{Value: "just you"}}},
+				"one": {Parts: []ast.Part{
+					&ast.Text{Value: "you and "},
+					&ast.NumberSign{},
+					&ast.Text{Value: " other"},
+				}},
+				"other": {Parts: []ast.Part{
+					&ast.Text{Value: "you and "},
+					&ast.NumberSign{},
+					&ast.Text{Value: " others"},
+				}},
+			}}},
+		{"{ 9, selectordinal, offset: 10 other{#th} }", &ast.PluralArg{
+			ArgID:   "9",
+			Ordinal: true,
+			Offset:  10,
+			Messages: map[string]*ast.Message{
+				"other": {Parts: []ast.Part{
+					&ast.NumberSign{},
+					&ast.Text{Value: "th"},
+				}},
+			}}},
 	} {
 		tc := tc
 		label := strconv.Itoa(idx)
@@ -172,13 +337,254 @@ func TestParseArgument(t *testing.T) {
 
 			dec := decoder.New(tc.pattern)
 
-			actual, err := parseArgument(dec, 0)
+			actual, err := parseArgument(dec, 0, nil)
 			require.NoError(err)
+			clearPositions([]ast.Part{actual})
 			require.Equal(tc.expected, actual)
 		})
 	}
 }
 
+func TestParseErrors(t *testing.T) {
+	for idx, tc := range []struct {
+		pattern  string
+		expected error
+	}{
+		{"Hello, {name",
+			&errors.UnterminatedArgument{
+				Pos:      pos(1, 13, 13),
+				Begin:    pos(1, 8, 8),
+				Expected: []string{"}", ","},
+			}},
+		{"Hello, {name!}",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 13, 13),
+				Token:    "!",
+				Expected: []string{"}", ","},
+			}},
+		{"Hello, {}",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 9, 9),
+				Token:    "}",
+				Expected: []string{"argument name or number"},
+			}},
+		{"{n, number, :: }",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 16, 16),
+				Token:    "}",
+				Expected: []string{"skeleton"},
+			}},
+		{"{n, number, ::foo{}}",
+			&errors.InvalidArgStyle{
+				Pos:      pos(1, 13, 13),
+				Keyword:  "::foo{}",
+				Expected: []string{"skeleton"},
+			}},
+		{"{n, number, ::foo{}",
+			&errors.InvalidArgStyle{
+				Pos:      pos(1, 13, 13),
+				Keyword:  "::foo{}",
+				Expected: []string{"skeleton"},
+			}},
+		{"{n, number, :percent}",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 14, 14),
+				Token:    "p",
+				Expected: []string{":"},
+			}},
+		{"Hello, {",
+			&errors.UnexpectedEOF{
+				Pos:      pos(1, 9, 9),
+				Expected: []string{"argument name or number"},
+			}},
+		{"{01}",
+			&errors.InvalidArgID{
+				Pos:      pos(1, 2, 2),
+				ID:       "01",
+				Expected: []string{"argument name or number"},
+			}},
+		{"{ 1st, number}",
+			&errors.InvalidArgID{
+				Pos:      pos(1, 3, 3),
+				ID:       "1st",
+				Expected: []string{"argument name or number"},
+			}},
+		{"{n,",
+			&errors.UnexpectedEOF{
+				Pos:      pos(1, 4, 4),
+				Expected: []string{"argument type"},
+			}},
+		{"{n, money}",
+			&errors.InvalidArgType{
+				Pos:     pos(1, 5, 5),
+				Keyword: "money",
+				Expected: []string{
+					"date", "duration", "number", "ordinal", "plural",
+					"select", "selectordinal", "spellout", "time",
+				},
+			}},
+		{"{n, number, lots}",
+			&errors.InvalidArgStyle{
+				Pos:     pos(1, 13, 13),
+				Keyword: "lots",
+				Expected: []string{
+					"currency", "full", "integer", "long", "medium", "percent", "short",
+				},
+			}},
+		{"{n, number, }",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 13, 13),
+				Token:    "}",
+				Expected: []string{"argument style"},
+			}},
+		{"{n, number,",
+			&errors.UnexpectedEOF{
+				Pos:      pos(1, 12, 12),
+				Expected: []string{"argument style"},
+			}},
+		{"{n, number short}",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 12, 12),
+				Token:    "s",
+				Expected: []string{"}", ","},
+			}},
+		{"{n, plural, one{#} lots{#}}",
+			&errors.InvalidPluralKey{
+				Pos:      pos(1, 20, 20),
+				Key:      "lots",
+				Expected: []string{"zero", "one", "two", "few", "many", "other"},
+			}},
+		{"{n, plural, =x{#} other{#}}",
+			&errors.InvalidPluralKey{
+				Pos:      pos(1, 13, 13),
+				Key:      "=x",
+				Expected: []string{"zero", "one", "two", "few", "many", "other"},
+			}},
+		{"{n, plural, ={#}}",
+			&errors.InvalidPluralKey{
+				Pos:      pos(1, 13, 13),
+				Key:      "=",
+				Expected: []string{"zero", "one", "two", "few", "many", "other"},
+			}},
+		{"{n, plural, offset:x other{#}}",
+			&errors.UnexpectedToken{
+				Pos:      pos(1, 20, 20),
+				Token:    "x",
+				Expected: []string{"digit"},
+			}},
+		{"{n, plural, offset:99999999999999999999999 other{#}}",
+			&errors.InvalidPluralOffset{
+				Pos:      pos(1, 20, 20),
+				Offset:   "99999999999999999999999",
+				Expected: []string{"offset up to 2147483647"},
+			}},
+		{"{n,plural,offset other{#}}",
+			&errors.InvalidPluralKey{
+				Pos:      pos(1, 11, 11),
+				Key:      "offset",
+				Expected: []string{"zero", "one", "two", "few", "many", "other"},
+			}},
+		{"{n, plural, other{#} offset:1}",
+			&errors.InvalidPluralKey{
+				Pos:      pos(1, 22, 22),
+				Key:      "offset",
+				Expected: []string{"zero", "one", "two", "few", "many", "other"},
+			}},
+		{"{n, select, yes{Sim} no{Não}",
+			&errors.UnterminatedArgument{
+				Pos:      pos(1, 30, 29),
+				Begin:    pos(1, 1, 1),
+				Expected: []string{"select key", "}"},
+			}},
+		{"{n, select, yes{Sim} other{{m}",
+			&errors.UnterminatedArgument{
+				Pos:      pos(1, 31, 31),
+				Begin:    pos(1, 1, 1),
+				Expected: []string{"}"},
+			}},
+	} {
+		tc := tc
+		label := strconv.Itoa(idx)
+		t.Run(label, func(t *testing.T) {
+			require := require.New(t)
+
+			_, err := Parse(tc.pattern)
+			require.Equal(tc.expected, err)
+		})
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	require := require.New(t)
+
+	msg, errs := ParseAll("{a, money} and {b} and {c, number, lots} and {d")
+	clearPositions(msg.Parts)
+	require.Len(errs, 3)
+	require.IsType(&errors.InvalidArgType{}, errs[0])
+	require.IsType(&errors.InvalidArgStyle{}, errs[1])
+	require.IsType(&errors.UnterminatedArgument{}, errs[2])
+	require.Equal(&ast.Message{Parts: []ast.Part{
+		&ast.BadArg{Source: "{a, money}", Err: errs[0]},
+		&ast.Text{Value: " and "},
+		&ast.PlainArg{ArgID: "b"},
+		&ast.Text{Value: " and "},
+		&ast.BadArg{Source: "{c, number, lots}", Err: errs[1]},
+		&ast.Text{Value: " and "},
+		&ast.BadArg{Source: "{d", Err: errs[2]},
+	}}, msg)
+
+	msg, errs = ParseAll(`{n, plural, one{# {x, bogus}} lots{#} other{# {y}}} {z`)
+	clearPositions(msg.Parts)
+	require.Len(errs, 3)
+	require.IsType(&errors.InvalidArgType{}, errs[0])
+	require.IsType(&errors.InvalidPluralKey{}, errs[1])
+	require.IsType(&errors.UnterminatedArgument{}, errs[2])
+	require.Equal(&ast.Message{Parts: []ast.Part{
+		&ast.PluralArg{
+			ArgID: "n",
+			Messages: map[string]*ast.Message{
+				"one": {Parts: []ast.Part{
+					&ast.NumberSign{},
+					&ast.Text{Value: " "},
+					&ast.BadArg{Source: "{x, bogus}", Err: errs[0]},
+				}},
+				"other": {Parts: []ast.Part{
+					&ast.NumberSign{},
+					&ast.Text{Value: " "},
+					&ast.PlainArg{ArgID: "y"},
+				}},
+			}},
+		&ast.Text{Value: " "},
+		&ast.BadArg{Source: "{z", Err: errs[2]},
+	}}, msg)
+
+	msg, errs = ParseAll("{n, plural, =x{none} =1x{one} other{#}}")
+	clearPositions(msg.Parts)
+	require.Equal([]error{
+		&errors.InvalidPluralKey{
+			Pos:      pos(1, 13, 13),
+			Key:      "=x",
+			Expected: []string{"zero", "one", "two", "few", "many", "other"},
+		},
+		&errors.InvalidPluralKey{
+			Pos:      pos(1, 22, 22),
+			Key:      "=1x",
+			Expected: []string{"zero", "one", "two", "few", "many", "other"},
+		},
+	}, errs)
+	require.Equal(&ast.Message{Parts: []ast.Part{
+		&ast.PluralArg{
+			ArgID: "n",
+			Messages: map[string]*ast.Message{
+				"other": {Parts: []ast.Part{&ast.NumberSign{}}},
+			}},
+	}}, msg)
+
+	msg, errs = ParseAll("Hello, {name}!")
+	require.Empty(errs)
+	require.Len(msg.Parts, 3)
+}
+
 func TestParseMessage(t *testing.T) {
 	for idx, tc := range []struct {
 		depth    int
@@ -227,8 +633,9 @@ func TestParseMessage(t *testing.T) {
 
 			dec := decoder.New(tc.pattern)
 
-			actual, err := parseMessage(dec, tc.depth, tc.inPlural)
+			actual, err := parseMessage(dec, tc.depth, tc.inPlural, nil)
 			require.NoError(err)
+			clearPositions(actual)
 			require.Equal(tc.expected, actual)
 		})
 	}
@@ -282,6 +689,10 @@ func TestParseMessageText(t *testing.T) {
 			&ast.Text{Value: "{{ foo }}"}},
 		{true, "'{# foo #}'",
 			&ast.Text{Value: "{# foo #}"}},
+		{true, "'{'#",
+			&ast.Text{Value: "{"}},
+		{false, "'}'{foo}",
+			&ast.Text{Value: "}"}},
 	} {
 		tc := tc
 		label := strconv.Itoa(idx)
@@ -292,6 +703,7 @@ func TestParseMessageText(t *testing.T) {
 
 			actual, err := parseMessageText(dec, 0, tc.inPlural)
 			require.NoError(err)
+			actual.Positions = nil
 			require.Equal(tc.expected, actual)
 		})
 	}
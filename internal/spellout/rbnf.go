@@ -0,0 +1,257 @@
+package spellout
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ruleBook holds the rule sets of one language, keyed by name.
+type ruleBook map[string]*ruleSet
+
+// ruleSet is a named list of rules. Private rule sets, whose names start
+// with "%%", can only be referenced by other rule sets.
+type ruleSet struct {
+	name     string
+	rules    []*rule // ordered by base value
+	negative *rule   // "-x", for numbers below zero
+	fraction *rule   // "x.x", for numbers with a fraction
+}
+
+// rule renders every number from its base value up to the base value of
+// the next rule in its set.
+type rule struct {
+	base    int64
+	divisor int64
+	tokens  []token
+}
+
+type tokenKind int
+
+const (
+	textToken      tokenKind = iota
+	quotientToken            // "<<", the number divided by the divisor
+	remainderToken           // ">>", the remainder of that division
+	sameToken                // "=%set=", the number itself
+	optionalToken            // "[...]", omitted when the remainder is zero
+)
+
+type token struct {
+	kind    tokenKind
+	text    string  // literal text, or the rule set of a substitution
+	pattern bool    // the substitution is a decimal pattern such as "#,##0"
+	tokens  []token // the contents of an optional token
+}
+
+// parseRules parses rule sets written in a subset of ICU's RBNF syntax.
+// Each rule set starts with "%name:" and holds rules such as
+// "20: twenty[->>];". Lines starting with "//" are comments.
+//
+// See https://unicode-org.github.io/icu/userguide/format_parse/numbers/rbnf.html
+func parseRules(src string) (ruleBook, error) {
+	var lines []string
+	for _, line := range strings.Split(src, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			lines = append(lines, line)
+		}
+	}
+
+	book := ruleBook{}
+	var set *ruleSet
+	for _, chunk := range strings.Split(strings.Join(lines, "\n"), ";") {
+		chunk = strings.TrimSpace(chunk)
+		if chunk == "" {
+			continue
+		}
+		if strings.HasPrefix(chunk, "%") {
+			i := strings.IndexByte(chunk, ':')
+			if i < 0 {
+				return nil, fmt.Errorf("missing colon after rule set name: %q", chunk)
+			}
+			name := chunk[:i]
+			if _, ok := book[name]; ok {
+				return nil, fmt.Errorf("duplicate rule set: %q", name)
+			}
+			set = &ruleSet{name: name}
+			book[name] = set
+			chunk = strings.TrimSpace(chunk[i+1:])
+		}
+		if set == nil {
+			return nil, fmt.Errorf("rule outside of a rule set: %q", chunk)
+		}
+		if err := set.parseRule(chunk); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, set := range book {
+		if err := book.check(set); err != nil {
+			return nil, err
+		}
+	}
+	return book, nil
+}
+
+func (s *ruleSet) parseRule(src string) error {
+	i := strings.IndexByte(src, ':')
+	if i < 0 {
+		return fmt.Errorf("missing colon after rule descriptor: %q", src)
+	}
+	descriptor := strings.TrimSpace(src[:i])
+	body := strings.TrimLeft(src[i+1:], " \t\n")
+	body = strings.TrimPrefix(body, "'")
+
+	tokens, rest, err := parseTokens(body, false)
+	if err != nil {
+		return fmt.Errorf("%s %s: %v", s.name, descriptor, err)
+	} else if rest != "" {
+		return fmt.Errorf("%s %s: unexpected %q", s.name, descriptor, rest)
+	}
+
+	switch descriptor {
+	case "-x":
+		s.negative = &rule{tokens: tokens}
+		return nil
+	case "x.x":
+		s.fraction = &rule{tokens: tokens}
+		return nil
+	}
+
+	var radix int64 = 10
+	if j := strings.IndexByte(descriptor, '/'); j >= 0 {
+		radix, err = strconv.ParseInt(descriptor[j+1:], 10, 64)
+		if err != nil || radix < 2 {
+			return fmt.Errorf("%s: invalid radix: %q", s.name, descriptor)
+		}
+		descriptor = descriptor[:j]
+	}
+	base, err := strconv.ParseInt(strings.Replace(descriptor, ",", "", -1), 10, 64)
+	if err != nil || base < 0 {
+		return fmt.Errorf("%s: invalid rule descriptor: %q", s.name, descriptor)
+	}
+	if n := len(s.rules); n > 0 && s.rules[n-1].base >= base {
+		return fmt.Errorf("%s: rules out of order at %d", s.name, base)
+	}
+
+	divisor := int64(1)
+	for divisor <= base/radix {
+		divisor *= radix
+	}
+	s.rules = append(s.rules, &rule{base: base, divisor: divisor, tokens: tokens})
+	return nil
+}
+
+// parseTokens splits a rule body into literal text and substitutions,
+// returning what follows the closing bracket when optional is true.
+func parseTokens(body string, optional bool) ([]token, string, error) {
+	var tokens []token
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			tokens = append(tokens, token{kind: textToken, text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(body); {
+		ch := body[i]
+		switch ch {
+		case '[':
+			if optional {
+				return nil, "", fmt.Errorf("nested brackets")
+			}
+			flush()
+			nested, rest, err := parseTokens(body[i+1:], true)
+			if err != nil {
+				return nil, "", err
+			}
+			tokens = append(tokens, token{kind: optionalToken, tokens: nested})
+			i = len(body) - len(rest)
+		case ']':
+			if !optional {
+				return nil, "", fmt.Errorf("unexpected closing bracket")
+			}
+			flush()
+			return tokens, body[i+1:], nil
+		case '<', '>', '=':
+			end := strings.IndexByte(body[i+1:], ch)
+			if end < 0 {
+				return nil, "", fmt.Errorf("unterminated substitution: %q", body[i:])
+			}
+			flush()
+			t := token{text: body[i+1 : i+1+end]}
+			switch ch {
+			case '<':
+				t.kind = quotientToken
+			case '>':
+				t.kind = remainderToken
+			default:
+				t.kind = sameToken
+			}
+			switch {
+			case t.text == "":
+				if t.kind == sameToken {
+					return nil, "", fmt.Errorf("substitution must name a rule set: \"==\"")
+				}
+			case strings.HasPrefix(t.text, "%"):
+			case strings.Trim(t.text, "#0,.") == "":
+				t.pattern = true
+			default:
+				return nil, "", fmt.Errorf("invalid substitution: %q", t.text)
+			}
+			tokens = append(tokens, t)
+			i += end + 2
+		default:
+			text.WriteByte(ch)
+			i++
+		}
+	}
+	if optional {
+		return nil, "", fmt.Errorf("unterminated bracket")
+	}
+	flush()
+	return tokens, "", nil
+}
+
+// check reports substitutions that name unknown rule sets.
+func (b ruleBook) check(set *ruleSet) error {
+	var walk func([]token) error
+	walk = func(tokens []token) error {
+		for _, t := range tokens {
+			switch {
+			case t.kind == optionalToken:
+				if err := walk(t.tokens); err != nil {
+					return err
+				}
+			case t.kind != textToken && !t.pattern && t.text != "":
+				if _, ok := b[t.text]; !ok {
+					return fmt.Errorf("%s: unknown rule set: %q", set.name, t.text)
+				}
+			}
+		}
+		return nil
+	}
+
+	rules := append([]*rule{}, set.rules...)
+	for _, r := range []*rule{set.negative, set.fraction} {
+		if r != nil {
+			rules = append(rules, r)
+		}
+	}
+	for _, r := range rules {
+		if err := walk(r.tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// find returns the rule with the largest base value not above n.
+func (s *ruleSet) find(n int64) *rule {
+	for i := len(s.rules) - 1; i >= 0; i-- {
+		if s.rules[i].base <= n {
+			return s.rules[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package spellout
+
+//go:generate go run ../../scripts/rbnf-to-go/main.go rules data.go
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+	"github.com/sjansen/messageformat/internal/number"
+)
+
+// DefaultRuleSet spells out cardinal numbers such as "twenty-one".
+const DefaultRuleSet = "%spellout-numbering"
+
+var books sync.Map // rule data key -> ruleBook
+
+// Speller spells out numbers with one public rule set of a language.
+type Speller struct {
+	lang language.Tag
+	book ruleBook
+	set  *ruleSet
+}
+
+// New returns a Speller for the named rule set, or for DefaultRuleSet
+// when name is empty. Languages without rule data are reported as an error.
+func New(lang language.Tag, name string) (*Speller, error) {
+	book, err := bookFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = DefaultRuleSet
+	}
+	set, ok := book[name]
+	if !ok || strings.HasPrefix(name, "%%") {
+		return nil, fmt.Errorf("unknown spellout rule set: %q", name)
+	}
+	return &Speller{lang: lang, book: book, set: set}, nil
+}
+
+func bookFor(lang language.Tag) (ruleBook, error) {
+	key, ok := dataKey(lang)
+	if !ok {
+		return nil, fmt.Errorf("no spellout rules for language: %q", lang)
+	}
+	if book, ok := books.Load(key); ok {
+		return book.(ruleBook), nil
+	}
+	book, err := parseRules(ruleData[key])
+	if err != nil {
+		return nil, fmt.Errorf("spellout rules for %s: %v", key, err)
+	}
+	books.Store(key, book)
+	return book, nil
+}
+
+func dataKey(lang language.Tag) (string, bool) {
+	for tag := lang; tag != language.Und; tag = tag.Parent() {
+		if _, ok := ruleData[tag.String()]; ok {
+			return tag.String(), true
+		}
+	}
+	base, _ := lang.Base()
+	if _, ok := ruleData[base.String()]; ok {
+		return base.String(), true
+	}
+	return "", false
+}
+
+// Format spells out d. Rule sets without a fraction rule round d to an
+// integer first.
+func (s *Speller) Format(d decimal.Decimal) (string, error) {
+	var b strings.Builder
+	if err := s.format(&b, s.set, d); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (s *Speller) format(b *strings.Builder, set *ruleSet, d decimal.Decimal) error {
+	if d.IsZero() {
+		d = decimal.Decimal{Int: "0"}
+	}
+	if d.Neg {
+		if set.negative == nil {
+			return fmt.Errorf("%s cannot spell out negative numbers", set.name)
+		}
+		abs := d.Abs()
+		return s.apply(b, set, set.negative, abs, abs, abs, false)
+	}
+	if !d.IsInteger() {
+		if set.fraction != nil {
+			q := decimal.Decimal{Int: d.Int}
+			r := decimal.Decimal{Int: "0", Frac: strings.TrimRight(d.Frac, "0")}
+			return s.apply(b, set, set.fraction, d, q, r, true)
+		}
+		d = d.Round(0)
+	}
+
+	n, err := strconv.ParseInt(d.Int, 10, 64)
+	if err != nil {
+		return fmt.Errorf("number too large to spell out: %s", d)
+	}
+	r := set.find(n)
+	if r == nil {
+		return fmt.Errorf("%s cannot spell out %d", set.name, n)
+	}
+	return s.apply(b, set, r, d, integer(n/r.divisor), integer(n%r.divisor), false)
+}
+
+// apply renders r for n split into quotient and remainder. The digits of
+// fraction remainders are spelled out one by one.
+func (s *Speller) apply(b *strings.Builder, set *ruleSet, r *rule, n, quotient, remainder decimal.Decimal, fraction bool) error {
+	var render func([]token) error
+	render = func(tokens []token) error {
+		for _, t := range tokens {
+			var err error
+			switch t.kind {
+			case textToken:
+				b.WriteString(t.text)
+			case optionalToken:
+				if !remainder.IsZero() {
+					err = render(t.tokens)
+				}
+			case quotientToken:
+				err = s.substitute(b, set, t, quotient)
+			case sameToken:
+				err = s.substitute(b, set, t, n)
+			case remainderToken:
+				if !fraction {
+					err = s.substitute(b, set, t, remainder)
+					break
+				}
+				for i, digit := range remainder.Frac {
+					if i > 0 {
+						b.WriteByte(' ')
+					}
+					if err = s.substitute(b, set, t, integer(int64(digit-'0'))); err != nil {
+						break
+					}
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return render(r.tokens)
+}
+
+func (s *Speller) substitute(b *strings.Builder, set *ruleSet, t token, d decimal.Decimal) error {
+	switch {
+	case t.pattern && strings.Contains(t.text, "."):
+		b.WriteString(number.Decimal(s.lang).Format(d))
+		return nil
+	case t.pattern:
+		b.WriteString(number.Integer(s.lang).Format(d))
+		return nil
+	case t.text != "":
+		set = s.book[t.text]
+	}
+	return s.format(b, set, d)
+}
+
+func integer(n int64) decimal.Decimal {
+	return decimal.Decimal{Int: strconv.FormatInt(n, 10)}
+}
@@ -0,0 +1,161 @@
+package spellout
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/sjansen/messageformat/internal/decimal"
+)
+
+func TestFormat(t *testing.T) {
+	for idx, tc := range []struct {
+		lang     string
+		ruleSet  string
+		number   string
+		expected string
+	}{
+		{"en", "", "0", "zero"},
+		{"en", "", "13", "thirteen"},
+		{"en", "", "42", "forty-two"},
+		{"en", "", "100", "one hundred"},
+		{"en", "", "123", "one hundred twenty-three"},
+		{"en", "", "1001", "one thousand one"},
+		{"en", "", "2500000", "two million five hundred thousand"},
+		{"en", "", "-7", "minus seven"},
+		{"en", "", "3.14", "three point one four"},
+		{"en", "", "1000000000000000000", "1,000,000,000,000,000,000"},
+		{"en", "%spellout-ordinal", "1", "first"},
+		{"en", "%spellout-ordinal", "12", "twelfth"},
+		{"en", "%spellout-ordinal", "15", "fifteenth"},
+		{"en", "%spellout-ordinal", "20", "twentieth"},
+		{"en", "%spellout-ordinal", "42", "forty-second"},
+		{"en", "%spellout-ordinal", "100", "one hundredth"},
+		{"en", "%spellout-ordinal", "101", "one hundred first"},
+		{"en", "%spellout-ordinal", "2.6", "third"},
+		{"en-GB", "", "21", "twenty-one"},
+		{"en-GB", "", "21", "twenty-one"},
+		{"de", "", "1", "eins"},
+		{"de", "", "17", "siebzehn"},
+		{"de", "", "21", "einundzwanzig"},
+		{"de", "", "101", "einhunderteins"},
+		{"de", "", "1999", "eintausendneunhundertneunundneunzig"},
+		{"de", "", "101000", "einhunderteintausend"},
+		{"de", "", "1000000", "eine Million"},
+		{"de", "", "3000021", "drei Millionen einundzwanzig"},
+		{"de", "", "2.5", "zwei Komma fünf"},
+		{"de", "%spellout-ordinal", "3", "dritte"},
+		{"de", "%spellout-ordinal", "19", "neunzehnte"},
+		{"de", "%spellout-ordinal", "21", "einundzwanzigste"},
+		{"de", "%spellout-ordinal", "100", "einhundertste"},
+		{"de", "%spellout-ordinal", "101", "einhunderterste"},
+		{"de-AT", "", "30", "dreißig"},
+		{"fr", "", "17", "dix-sept"},
+		{"fr", "", "21", "vingt-et-un"},
+		{"fr", "", "71", "soixante-et-onze"},
+		{"fr", "", "77", "soixante-dix-sept"},
+		{"fr", "", "80", "quatre-vingts"},
+		{"fr", "", "91", "quatre-vingt-onze"},
+		{"fr", "", "200", "deux cents"},
+		{"fr", "", "201", "deux cent un"},
+		{"fr", "", "80000", "quatre-vingt mille"},
+		{"fr", "", "2000000", "deux millions"},
+		{"fr", "", "-1.5", "moins un virgule cinq"},
+		{"fr", "%spellout-ordinal", "1", "premier"},
+		{"fr", "%spellout-ordinal", "21", "vingt-et-unième"},
+		{"fr", "%spellout-ordinal", "30", "trentième"},
+		{"fr", "%spellout-ordinal", "71", "soixante-et-onzième"},
+		{"fr", "%spellout-ordinal", "81", "quatre-vingt-unième"},
+		{"fr", "%spellout-ordinal", "101", "cent unième"},
+		{"fr", "%spellout-ordinal", "1000", "millième"},
+		{"fr", "%spellout-ordinal", "1001", "mille unième"},
+		{"es", "", "16", "dieciséis"},
+		{"es", "", "19", "diecinueve"},
+		{"es", "", "28", "veintiocho"},
+		{"es", "", "45", "cuarenta y cinco"},
+		{"es", "", "100", "cien"},
+		{"es", "", "101", "ciento uno"},
+		{"es", "", "21000", "veintiún mil"},
+		{"es", "", "1000000", "un millón"},
+		{"es", "", "1000000000", "mil millones"},
+		{"es", "%spellout-ordinal", "3", "tercero"},
+		{"es", "%spellout-ordinal", "14", "decimocuarto"},
+		{"es", "%spellout-ordinal", "21", "vigésimo primero"},
+		{"es", "%spellout-ordinal", "2000", "dos milésimo"},
+		{"pt", "", "16", "dezesseis"},
+		{"pt", "", "21", "vinte e um"},
+		{"pt", "", "100", "cem"},
+		{"pt", "", "115", "cento e quinze"},
+		{"pt", "", "1001", "mil e um"},
+		{"pt", "", "1200", "mil e duzentos"},
+		{"pt", "", "1234", "mil duzentos e trinta e quatro"},
+		{"pt", "", "2000000", "dois milhões"},
+		{"pt-PT", "", "3", "três"},
+		{"pt", "%spellout-ordinal", "2", "segundo"},
+		{"pt", "%spellout-ordinal", "11", "décimo primeiro"},
+		{"pt", "%spellout-ordinal", "345", "trecentésimo quadragésimo quinto"},
+	} {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			require := require.New(t)
+
+			s, err := New(language.Make(tc.lang), tc.ruleSet)
+			require.NoError(err)
+			d, err := decimal.Parse(tc.number)
+			require.NoError(err)
+			actual, err := s.Format(d)
+			require.NoError(err)
+			require.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	require := require.New(t)
+
+	s, err := New(language.English, "%spellout-numbering")
+	require.NoError(err)
+	d, err := decimal.Parse("10000000000000000000")
+	require.NoError(err)
+	_, err = s.Format(d)
+	require.Error(err)
+
+	_, err = New(language.English, "%%tieth")
+	require.Error(err)
+	_, err = New(language.English, "%spellout-roman")
+	require.Error(err)
+	_, err = New(language.Japanese, "")
+	require.Error(err)
+}
+
+func TestRuleData(t *testing.T) {
+	for lang, data := range ruleData {
+		book, err := parseRules(data)
+		require.NoError(t, err, lang)
+		require.Contains(t, book, DefaultRuleSet, lang)
+		require.Contains(t, book, "%spellout-ordinal", lang)
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	for _, src := range []string{
+		"0: zero;",
+		"%numbers 0: zero;",
+		"%numbers: zero;",
+		"%numbers: 1: one; 0: zero;",
+		"%numbers: 0: zero; %numbers: 1: one;",
+		"%numbers: 10/1: ten;",
+		"%numbers: 20: twenty[->>;",
+		"%numbers: 20: twenty->>];",
+		"%numbers: 20: twenty[-[>>]];",
+		"%numbers: 20: twenty->;",
+		"%numbers: 20: twenty-==;",
+		"%numbers: 20: twenty->roman>;",
+		"%numbers: 20: twenty->%missing>;",
+	} {
+		_, err := parseRules(src)
+		require.Error(t, err, src)
+	}
+}
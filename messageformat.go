@@ -1,10 +1,145 @@
+// Package messageformat parses and formats ICU MessageFormat patterns.
 package messageformat
 
 import (
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/sjansen/messageformat/ast"
+	"github.com/sjansen/messageformat/internal/compiler"
 	"github.com/sjansen/messageformat/internal/parser"
 )
 
+// Message is a compiled pattern, ready to be formatted. A Message is
+// immutable and safe for concurrent use.
+type Message struct {
+	compiled *compiler.Message
+}
+
+// Amount is a value in the currency identified by ISOCode, such as "EUR".
+// Amounts, like golang.org/x/text/currency.Amount, can be formatted with
+// "{price, number, currency}"; plain numbers use the locale's currency.
+type Amount = compiler.Amount
+
+// Now is a date or time argument value that stands for the current time,
+// as reported by the clock of the message.
+var Now = compiler.Now
+
+// Option configures how a compiled message renders its arguments.
+type Option func(*compiler.Options)
+
+// WithLocation shows dates and times in loc. By default time.Time values
+// are shown in their own location and timestamps in UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(o *compiler.Options) {
+		o.Location = loc
+	}
+}
+
+// WithClock sets the function that reports the current time for Now
+// values, which defaults to time.Now. It allows deterministic tests.
+func WithClock(now func() time.Time) Option {
+	return func(o *compiler.Options) {
+		o.Now = now
+	}
+}
+
+// Parse parses a pattern into an abstract syntax tree.
 func Parse(s string) (*ast.Message, error) {
 	return parser.Parse(s)
 }
+
+// ParseAll is like Parse but reports every error in the pattern instead of
+// stopping at the first. The returned tree is always non-nil; arguments
+// that could not be parsed are represented by *ast.BadArg.
+func ParseAll(s string) (*ast.Message, []error) {
+	return parser.ParseAll(s)
+}
+
+// Compile parses a pattern and compiles it for the BCP 47 language tag lang.
+// Date and time arguments can only be compiled for languages with bundled
+// calendar data: de, en, es, fr, it, ja, nl, pl, pt, ru, sv and zh, as can
+// ordinal arguments and duration arguments in the long and short styles.
+// Spellout arguments need rule data, bundled for de, en, es, fr and pt.
+func Compile(lang, pattern string, options ...Option) (*Message, error) {
+	msg, err := parser.Parse(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return CompileAST(lang, msg, options...)
+}
+
+// CompileAST compiles an already parsed pattern for the BCP 47 language
+// tag lang.
+func CompileAST(lang string, msg *ast.Message, options ...Option) (*Message, error) {
+	compiled, err := compiler.Compile(lang, msg)
+	if err != nil {
+		return nil, err
+	}
+	m := &Message{compiled: compiled}
+	if len(options) > 0 {
+		m = m.with(options...)
+	}
+	return m, nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be compiled.
+// It simplifies initialization of global variables holding messages.
+func MustCompile(lang, pattern string, options ...Option) *Message {
+	m, err := Compile(lang, pattern, options...)
+	if err != nil {
+		panic(`messageformat: Compile(` + strconv.Quote(pattern) + `): ` + err.Error())
+	}
+	return m
+}
+
+// Format renders the message. Arguments are looked up by the ID used in
+// the pattern, either as the keys of a map[string]interface{} or as the
+// exported fields of a struct or pointer to struct. Fields match by name
+// or by an `mf:"id"` tag; fields tagged `mf:"-"` are ignored. Fields of
+// embedded structs are promoted following the rules of encoding/json. If every
+// argument in the pattern is numbered, arguments may also be a slice
+// indexed by argument number.
+func (m *Message) Format(arguments interface{}) (string, error) {
+	return m.compiled.Format(arguments)
+}
+
+// FormatArgs renders a message whose arguments are all numbered, such as
+// "{0} of {1}", using args as the values of arguments 0, 1 and so on.
+// Format also accepts numbered values as a slice.
+func (m *Message) FormatArgs(args ...interface{}) (string, error) {
+	return m.compiled.Format(args)
+}
+
+// FormatTo renders the message directly to w. It accepts the same
+// arguments as Format. If an error occurs part of the message may
+// already have been written.
+func (m *Message) FormatTo(w io.Writer, arguments interface{}) error {
+	return m.compiled.FormatTo(w, arguments)
+}
+
+// AppendFormat appends the rendered message to dst and returns the
+// extended buffer. On error dst is returned unchanged.
+func (m *Message) AppendFormat(dst []byte, arguments interface{}) ([]byte, error) {
+	return m.compiled.AppendFormat(dst, arguments)
+}
+
+// In returns a copy of the message that shows dates and times in loc, such
+// as the time zone of the user being served. The message is not changed.
+func (m *Message) In(loc *time.Location) *Message {
+	return m.with(WithLocation(loc))
+}
+
+func (m *Message) with(options ...Option) *Message {
+	o := m.compiled.Options()
+	for _, option := range options {
+		option(&o)
+	}
+	return &Message{compiled: m.compiled.WithOptions(o)}
+}
+
+// Language returns the BCP 47 tag the message was compiled for.
+func (m *Message) Language() string {
+	return m.compiled.Language().String()
+}
@@ -1,9 +1,16 @@
 package messageformat
 
 import (
+	"bytes"
+	stderrors "errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/currency"
+
+	"github.com/sjansen/messageformat/errors"
 )
 
 var messages = map[string]string{
@@ -20,3 +27,297 @@ func TestParse(t *testing.T) {
 		require.NotNil(msg)
 	}
 }
+
+func TestParseAll(t *testing.T) {
+	require := require.New(t)
+
+	msg, errs := ParseAll(`{n, plural, one{# item} lots{# items}} in {folder, bogus}`)
+	require.NotNil(msg)
+	require.Len(errs, 2)
+	for _, err := range errs {
+		var e errors.Error
+		require.True(stderrors.As(err, &e))
+	}
+}
+
+func TestCompile(t *testing.T) {
+	for lang, tc := range map[string]struct {
+		one   string
+		other string
+	}{
+		"en": {
+			"There is 1 item in your inbox.",
+			"There are 3 items in your inbox.",
+		},
+		"pt": {
+			"Existe 1 item na sua caixa de entrada.",
+			"Existem 3 itens na sua caixa de entrada.",
+		},
+	} {
+		lang, tc := lang, tc
+		t.Run(lang, func(t *testing.T) {
+			require := require.New(t)
+
+			msg, err := Compile(lang, messages[lang])
+			require.NoError(err)
+			require.Equal(lang, msg.Language())
+
+			actual, err := msg.Format(map[string]interface{}{"n": 1})
+			require.NoError(err)
+			require.Equal(tc.one, actual)
+
+			actual, err = msg.Format(map[string]interface{}{"n": 3})
+			require.NoError(err)
+			require.Equal(tc.other, actual)
+		})
+	}
+}
+
+func TestCompileWithOffset(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", `{guests, plural, offset:1
+	    =0 {{host} does not give a party.}
+	    =1 {{host} invites {guest} to the party.}
+	    =2 {{host} invites {guest} and one other person to the party.}
+	    other {{host} invites {guest} and # other people to the party.}}`)
+
+	for guests, expected := range map[int]string{
+		0:    "Ann does not give a party.",
+		1:    "Ann invites Bob to the party.",
+		2:    "Ann invites Bob and one other person to the party.",
+		3:    "Ann invites Bob and 2 other people to the party.",
+		9:    "Ann invites Bob and 8 other people to the party.",
+		1235: "Ann invites Bob and 1,234 other people to the party.",
+	} {
+		actual, err := msg.Format(map[string]interface{}{
+			"guests": guests,
+			"host":   "Ann",
+			"guest":  "Bob",
+		})
+		require.NoError(err)
+		require.Equal(expected, actual)
+	}
+}
+
+func TestFormatTo(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "Hello, {name}! You have {n, plural, one{# message} other{# messages}}.")
+	arguments := map[string]interface{}{"name": "Alice", "n": 2}
+
+	var b bytes.Buffer
+	err := msg.FormatTo(&b, arguments)
+	require.NoError(err)
+	require.Equal("Hello, Alice! You have 2 messages.", b.String())
+
+	w := &limitedWriter{n: 10}
+	err = msg.FormatTo(w, arguments)
+	require.Equal(io.ErrShortWrite, err)
+	require.Equal("Hello, Ali", w.b.String())
+
+	dst := []byte("> ")
+	dst, err = msg.AppendFormat(dst, arguments)
+	require.NoError(err)
+	require.Equal("> Hello, Alice! You have 2 messages.", string(dst))
+
+	dst, err = msg.AppendFormat(dst[:2], map[string]interface{}{"n": 1})
+	require.Error(err)
+	require.Equal("> ", string(dst))
+}
+
+func TestFormatStruct(t *testing.T) {
+	require := require.New(t)
+
+	type inbox struct {
+		Owner string `mf:"owner"`
+		Count int    `mf:"n"`
+	}
+
+	msg := MustCompile("en", messages["en"]+" Thanks, {owner}.")
+	actual, err := msg.Format(&inbox{Owner: "Alice", Count: 5})
+	require.NoError(err)
+	require.Equal("There are 5 items in your inbox. Thanks, Alice.", actual)
+}
+
+func TestFormatCurrency(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("de", "Preis: {price, number, currency}")
+	yen := currency.JPY.Amount(1500)
+	for _, tc := range []struct {
+		price    interface{}
+		expected string
+	}{
+		{19.999, "Preis: 20,00\u00a0€"},
+		{Amount{Value: 1234.5, ISOCode: "USD"}, "Preis: 1.234,50\u00a0$"},
+		{&Amount{Value: "1500.4", ISOCode: "JPY"}, "Preis: 1.500\u00a0¥"},
+		{Amount{Value: 0.125, ISOCode: "CHF"}, "Preis: 0,12\u00a0CHF"},
+		{currency.CHF.Amount(0.125), "Preis: 0,12\u00a0CHF"},
+		{&yen, "Preis: 1.500\u00a0¥"},
+	} {
+		actual, err := msg.Format(map[string]interface{}{"price": tc.price})
+		require.NoError(err)
+		require.Equal(tc.expected, actual)
+	}
+
+	_, err := msg.Format(map[string]interface{}{"price": Amount{Value: 1, ISOCode: "XYZZY"}})
+	require.Error(err)
+	_, err = msg.Format(map[string]interface{}{"price": currency.CHF.Amount("lots")})
+	require.Error(err)
+
+	msg = MustCompile("en", "{a, number, ::currency/CAD unit-width-narrow}, {a, number, ::currency/CAD unit-width-iso-code} or {a, number, ¤¤¤¤¤0.00}")
+	actual, err := msg.Format(map[string]interface{}{"a": Amount{Value: 5, ISOCode: "CAD"}})
+	require.NoError(err)
+	require.Equal("$5.00, CAD\u00a05.00 or $5.00", actual)
+}
+
+func TestFormatDuration(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "Finished in {elapsed, duration} ({elapsed, duration, long}).")
+	actual, err := msg.Format(map[string]interface{}{"elapsed": 62 * time.Minute})
+	require.NoError(err)
+	require.Equal("Finished in 1:02:00 (1 hour, 2 minutes).", actual)
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "You finished {place, ordinal} of {total, number}.")
+	actual, err := msg.Format(map[string]interface{}{"place": 22, "total": 1500})
+	require.NoError(err)
+	require.Equal("You finished 22nd of 1,500.", actual)
+
+	_, err = msg.Format(map[string]interface{}{"place": 1.5, "total": 1500})
+	require.Error(err)
+}
+
+func TestFormatSpellout(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "The {n, spellout, %spellout-ordinal} of {total, spellout} runners.")
+	actual, err := msg.Format(map[string]interface{}{"n": 3, "total": 121})
+	require.NoError(err)
+	require.Equal("The third of one hundred twenty-one runners.", actual)
+}
+
+func TestFormatSkeleton(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "Paid {amount, number, ::currency/EUR .00} on {d, date, ::yMMMd}.")
+	actual, err := msg.Format(map[string]interface{}{
+		"amount": 1234.5,
+		"d":      time.Date(2020, time.March, 7, 15, 4, 5, 0, time.UTC),
+	})
+	require.NoError(err)
+	require.Equal("Paid €1,234.50 on Mar 7, 2020.", actual)
+
+	msg = MustCompile("de", "Noch {n, number, ::unit/kilometer unit-width-full-name .0}")
+	actual, err = msg.Format(map[string]interface{}{"n": 12})
+	require.NoError(err)
+	require.Equal("Noch 12,0 Kilometer", actual)
+}
+
+func TestFormatCustomPatterns(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("de", "{n, number, #,##0.00 'Stück'} am {d, date, dd.MM.yyyy}")
+	actual, err := msg.Format(map[string]interface{}{
+		"n": 1234.5,
+		"d": time.Date(2020, time.March, 7, 15, 4, 5, 0, time.UTC),
+	})
+	require.NoError(err)
+	require.Equal("1.234,50 Stück am 07.03.2020", actual)
+}
+
+func TestFormatWithLocation(t *testing.T) {
+	require := require.New(t)
+
+	la, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(err)
+	at := time.Date(2020, time.July, 15, 18, 30, 0, 0, time.UTC)
+
+	msg := MustCompile("en", "Starts {t, time, h:mm a zzzz}.", WithLocation(la))
+	actual, err := msg.Format(map[string]interface{}{"t": at})
+	require.NoError(err)
+	require.Equal("Starts 11:30 AM Pacific Daylight Time.", actual)
+
+	actual, err = msg.In(berlin).Format(map[string]interface{}{"t": at.Unix()})
+	require.NoError(err)
+	require.Equal("Starts 8:30 PM Central European Summer Time.", actual)
+
+	msg = MustCompile("de", "Erstellt am {d, date, long} um {d, time, short}.",
+		WithLocation(berlin),
+		WithClock(func() time.Time { return at }),
+	)
+	actual, err = msg.Format(map[string]interface{}{"d": Now})
+	require.NoError(err)
+	require.Equal("Erstellt am 15. Juli 2020 um 20:30.", actual)
+}
+
+func TestFormatArgs(t *testing.T) {
+	require := require.New(t)
+
+	msg := MustCompile("en", "{0} of {1}: {2, plural, one{# file} other{# files}}")
+	actual, err := msg.FormatArgs(3, 7, 2)
+	require.NoError(err)
+	require.Equal("3 of 7: 2 files", actual)
+
+	actual, err = msg.Format([]interface{}{1, int64(7), 1})
+	require.NoError(err)
+	require.Equal("1 of 7: 1 file", actual)
+
+	actual, err = msg.Format(map[string]interface{}{"0": "two", "1": 7.5, "2": 5})
+	require.NoError(err)
+	require.Equal("two of 7.5: 5 files", actual)
+
+	actual, err = MustCompile("de", "{0} von {1}").FormatArgs(1200, 2500)
+	require.NoError(err)
+	require.Equal("1.200 von 2.500", actual)
+
+	_, err = msg.FormatArgs(3, struct{}{}, 2)
+	require.Error(err)
+
+	_, err = msg.FormatArgs(3, 7)
+	require.Error(err)
+
+	msg = MustCompile("en", "{0} of {total}")
+	_, err = msg.FormatArgs(3, 7)
+	require.Error(err)
+}
+
+type limitedWriter struct {
+	b bytes.Buffer
+	n int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		w.b.Write(p[:w.n])
+		n := w.n
+		w.n = 0
+		return n, io.ErrShortWrite
+	}
+	w.n -= len(p)
+	return w.b.Write(p)
+}
+
+func TestCompileErrors(t *testing.T) {
+	require := require.New(t)
+
+	_, err := Compile("en", "{n, plural, one{#}}")
+	require.Error(err)
+
+	_, err = Compile("!!", "Spoon!")
+	require.Error(err)
+
+	require.Panics(func() {
+		MustCompile("en", "{n, bogus}")
+	})
+	require.NotPanics(func() {
+		MustCompile("en", "Spoon!")
+	})
+}
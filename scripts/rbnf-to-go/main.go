@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: rbnf-to-go SRCDIR DST")
+		os.Exit(1)
+	}
+	src, dst := os.Args[1], os.Args[2]
+
+	pkg := os.Getenv("GOPACKAGE")
+	if pkg == "" {
+		fmt.Fprintln(os.Stderr, "GOPACKAGE not set, run with go generate")
+		os.Exit(1)
+	}
+
+	files, err := filepath.Glob(filepath.Join(src, "*.rbnf"))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	} else if len(files) < 1 {
+		fmt.Fprintf(os.Stderr, "no rule files found: %q\n", src)
+		os.Exit(1)
+	}
+	sort.Strings(files)
+	fmt.Println("generating:", dst)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by scripts/rbnf-to-go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("// ruleData holds the rule files of each language, keyed by language tag.\n")
+	buf.WriteString("var ruleData = map[string]string{\n")
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if bytes.IndexByte(data, '`') >= 0 {
+			fmt.Fprintf(os.Stderr, "backquote in rule file: %q\n", file)
+			os.Exit(1)
+		}
+		lang := strings.TrimSuffix(filepath.Base(file), ".rbnf")
+		fmt.Fprintf(&buf, "%q: `%s`,\n", lang, data)
+	}
+	buf.WriteString("}\n")
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err = ioutil.WriteFile(dst, code, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}